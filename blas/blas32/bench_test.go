@@ -0,0 +1,80 @@
+package blas32
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+// gemmBenchShapes are the m×n×k sizes of the Gemm benchmarks: square
+// matrices, and skinny ones where one of the dimensions is small.
+var gemmBenchShapes = [][3]int{
+	{64, 64, 64},
+	{256, 256, 256},
+	{512, 512, 512},
+	{1024, 1024, 1024},
+	{1024, 1024, 16},
+	{16, 1024, 1024},
+	{1024, 16, 1024},
+	{4096, 64, 256},
+}
+
+func randSlice(rnd *rand.Rand, n int) []float32 {
+	s := make([]float32, n)
+	for i := range s {
+		s[i] = rnd.Float32()
+	}
+	return s
+}
+
+// BenchmarkGemm compares Gemm with the parallel 64×64 tiling it replaced for
+// large problems, which is still used when m or k is small. Both use
+// GOMAXPROCS workers.
+func BenchmarkGemm(b *testing.B) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, sh := range gemmBenchShapes {
+		m, n, k := sh[0], sh[1], sh[2]
+		a := randSlice(rnd, m*k)
+		bm := randSlice(rnd, k*n)
+		c := randSlice(rnd, m*n)
+		flops := 2 * float64(m) * float64(n) * float64(k)
+		for _, tr := range []struct {
+			name           string
+			aTrans, bTrans bool
+		}{
+			{"NN", false, false},
+			{"TN", true, false},
+			{"NT", false, true},
+		} {
+			lda, ldb := k, n
+			if tr.aTrans {
+				lda = m
+			}
+			if tr.bTrans {
+				ldb = k
+			}
+			tA, tB := blas.NoTrans, blas.NoTrans
+			if tr.aTrans {
+				tA = blas.Trans
+			}
+			if tr.bTrans {
+				tB = blas.Trans
+			}
+			name := fmt.Sprintf("%dx%dx%d/%s", m, n, k, tr.name)
+			b.Run(name+"/Gemm", func(b *testing.B) {
+				for b.Loop() {
+					Gemm(tA, tB, m, n, k, 1, a, lda, bm, ldb, 1, c, n)
+				}
+				b.ReportMetric(flops*float64(b.N)/b.Elapsed().Seconds()/1e9, "GFLOPS")
+			})
+			b.Run(name+"/Tiled", func(b *testing.B) {
+				for b.Loop() {
+					dgemmTiled(tr.aTrans, tr.bTrans, m, n, k, a, lda, bm, ldb, c, n, 1)
+				}
+				b.ReportMetric(flops*float64(b.N)/b.Elapsed().Seconds()/1e9, "GFLOPS")
+			})
+		}
+	}
+}
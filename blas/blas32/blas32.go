@@ -0,0 +1,288 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blas32
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+// Implementation is the pure Go BLAS implementation provided by this package.
+// It is registered under the name "go" and is the backend used by the package
+// level functions unless another one is selected with Use.
+type Implementation struct{}
+
+var _ blas.Float32 = Implementation{}
+
+// backend is a registered BLAS implementation. If load is not nil, impl is
+// set by calling it the first time the backend is selected with Use.
+type backend struct {
+	name string
+	impl blas.Float32
+	load func() (blas.Float32, error)
+}
+
+var (
+	mu       sync.Mutex
+	backends = map[string]*backend{}
+	active   atomic.Pointer[backend]
+)
+
+func init() {
+	Register("go", Implementation{})
+	if err := Use("go"); err != nil {
+		panic(err)
+	}
+}
+
+// Register makes the BLAS implementation b available to Use under the given
+// name. Register panics if b is nil or if name is already registered.
+func Register(name string, b blas.Float32) {
+	if b == nil {
+		panic("blas32: nil implementation registered as " + name)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := backends[name]; ok {
+		panic("blas32: implementation registered twice as " + name)
+	}
+	backends[name] = &backend{name: name, impl: b}
+}
+
+// registerLoader makes the BLAS implementation returned by load available to
+// Use under the given name. load is called by the first successful Use of
+// name, and again by later ones for as long as it returns an error.
+func registerLoader(name string, load func() (blas.Float32, error)) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := backends[name]; ok {
+		panic("blas32: implementation registered twice as " + name)
+	}
+	backends[name] = &backend{name: name, load: load}
+}
+
+// Use makes the implementation registered under name the one called by the
+// package level functions. Use returns an error if no implementation has been
+// registered with that name, or if it is provided by a library that cannot be
+// loaded. On error the selected implementation is unchanged. Calls already in
+// progress complete with the previous implementation.
+func Use(name string) error {
+	mu.Lock()
+	defer mu.Unlock()
+	b, ok := backends[name]
+	if !ok {
+		return fmt.Errorf("blas32: no implementation registered as %q", name)
+	}
+	if b.impl == nil {
+		impl, err := b.load()
+		if err != nil {
+			return fmt.Errorf("blas32: loading %q: %w", name, err)
+		}
+		b.impl = impl
+	}
+	active.Store(b)
+	return nil
+}
+
+// Backend returns the name of the implementation called by the package level
+// functions.
+func Backend() string {
+	return active.Load().name
+}
+
+// Backends returns the sorted names of the registered implementations.
+func Backends() []string {
+	mu.Lock()
+	defer mu.Unlock()
+	names := make([]string, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Axpy adds alpha times x to y.
+func Axpy(n int, alpha float32, x []float32, incX int, y []float32, incY int) {
+	active.Load().impl.Axpy(n, alpha, x, incX, y, incY)
+}
+
+// Scal scales x by alpha.
+func Scal(n int, alpha float32, x []float32, incX int) {
+	active.Load().impl.Scal(n, alpha, x, incX)
+}
+
+// Copy copies the elements of x into the elements of y.
+func Copy(n int, x []float32, incX int, y []float32, incY int) {
+	active.Load().impl.Copy(n, x, incX, y, incY)
+}
+
+// Swap exchanges the elements of two vectors.
+func Swap(n int, x []float32, incX int, y []float32, incY int) {
+	active.Load().impl.Swap(n, x, incX, y, incY)
+}
+
+// Dot computes the dot product of the two vectors.
+func Dot(n int, x []float32, incX int, y []float32, incY int) float32 {
+	return active.Load().impl.Dot(n, x, incX, y, incY)
+}
+
+// Nrm2 computes the Euclidean norm of a vector.
+func Nrm2(n int, x []float32, incX int) float32 {
+	return active.Load().impl.Nrm2(n, x, incX)
+}
+
+// Asum computes the sum of the absolute values of the elements of x.
+func Asum(n int, x []float32, incX int) float32 {
+	return active.Load().impl.Asum(n, x, incX)
+}
+
+// Iamax returns the index of an element of x with the largest absolute value.
+func Iamax(n int, x []float32, incX int) int {
+	return active.Load().impl.Iamax(n, x, incX)
+}
+
+// Rotg computes a plane rotation.
+func Rotg(a, b float32) (c, s, r, z float32) {
+	return active.Load().impl.Rotg(a, b)
+}
+
+// Rot applies a plane rotation to the vectors x and y.
+func Rot(n int, x []float32, incX int, y []float32, incY int, c float32, s float32) {
+	active.Load().impl.Rot(n, x, incX, y, incY, c, s)
+}
+
+// Rotmg computes the modified Givens rotation.
+func Rotmg(d1, d2, x1, y1 float32) (p blas.SrotmParams, rd1, rd2, rx1 float32) {
+	return active.Load().impl.Rotmg(d1, d2, x1, y1)
+}
+
+// Rotm applies the modified Givens rotation to the 2×n matrix.
+func Rotm(n int, x []float32, incX int, y []float32, incY int, p blas.SrotmParams) {
+	active.Load().impl.Rotm(n, x, incX, y, incY, p)
+}
+
+// Gemv computes y = alpha * op(A) * x + beta * y for a general m×n matrix A.
+func Gemv(tA blas.Transpose, m, n int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
+	active.Load().impl.Gemv(tA, m, n, alpha, a, lda, x, incX, beta, y, incY)
+}
+
+// Symv computes y = alpha * A * x + beta * y for a symmetric n×n matrix A.
+func Symv(ul blas.Uplo, n int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
+	active.Load().impl.Symv(ul, n, alpha, a, lda, x, incX, beta, y, incY)
+}
+
+// Trmv computes x = op(A) * x for a triangular n×n matrix A.
+func Trmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float32, lda int, x []float32, incX int) {
+	active.Load().impl.Trmv(ul, tA, d, n, a, lda, x, incX)
+}
+
+// Trsv solves op(A) * x = b for a triangular n×n matrix A, storing x in place of b.
+func Trsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float32, lda int, x []float32, incX int) {
+	active.Load().impl.Trsv(ul, tA, d, n, a, lda, x, incX)
+}
+
+// Ger performs the rank-one update A += alpha * x * yᵀ.
+func Ger(m, n int, alpha float32, x []float32, incX int, y []float32, incY int, a []float32, lda int) {
+	active.Load().impl.Ger(m, n, alpha, x, incX, y, incY, a, lda)
+}
+
+// Syr performs the symmetric rank-one update A += alpha * x * xᵀ.
+func Syr(ul blas.Uplo, n int, alpha float32, x []float32, incX int, a []float32, lda int) {
+	active.Load().impl.Syr(ul, n, alpha, x, incX, a, lda)
+}
+
+// Syr2 performs the symmetric rank-two update A += alpha * x * yᵀ + alpha * y * xᵀ.
+func Syr2(ul blas.Uplo, n int, alpha float32, x []float32, incX int, y []float32, incY int, a []float32, lda int) {
+	active.Load().impl.Syr2(ul, n, alpha, x, incX, y, incY, a, lda)
+}
+
+// Gbmv computes y = alpha * op(A) * x + beta * y for a general band matrix A.
+func Gbmv(tA blas.Transpose, m, n, kL, kU int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
+	active.Load().impl.Gbmv(tA, m, n, kL, kU, alpha, a, lda, x, incX, beta, y, incY)
+}
+
+// Sbmv computes y = alpha * A * x + beta * y for a symmetric band matrix A.
+func Sbmv(ul blas.Uplo, n, k int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
+	active.Load().impl.Sbmv(ul, n, k, alpha, a, lda, x, incX, beta, y, incY)
+}
+
+// Tbmv computes x = op(A) * x for a triangular band matrix A.
+func Tbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []float32, lda int, x []float32, incX int) {
+	active.Load().impl.Tbmv(ul, tA, d, n, k, a, lda, x, incX)
+}
+
+// Tbsv solves op(A) * x = b for a triangular band matrix A, storing x in place of b.
+func Tbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []float32, lda int, x []float32, incX int) {
+	active.Load().impl.Tbsv(ul, tA, d, n, k, a, lda, x, incX)
+}
+
+// Spmv computes y = alpha * A * x + beta * y for a symmetric matrix A in packed
+// format.
+func Spmv(ul blas.Uplo, n int, alpha float32, ap []float32, x []float32, incX int, beta float32, y []float32, incY int) {
+	active.Load().impl.Spmv(ul, n, alpha, ap, x, incX, beta, y, incY)
+}
+
+// Tpmv computes x = op(A) * x for a triangular matrix A in packed format.
+func Tpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap []float32, x []float32, incX int) {
+	active.Load().impl.Tpmv(ul, tA, d, n, ap, x, incX)
+}
+
+// Tpsv solves op(A) * x = b for a triangular matrix A in packed format, storing x in
+// place of b.
+func Tpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap []float32, x []float32, incX int) {
+	active.Load().impl.Tpsv(ul, tA, d, n, ap, x, incX)
+}
+
+// Spr performs the symmetric rank-one update A += alpha * x * xᵀ for A in
+// packed format.
+func Spr(ul blas.Uplo, n int, alpha float32, x []float32, incX int, ap []float32) {
+	active.Load().impl.Spr(ul, n, alpha, x, incX, ap)
+}
+
+// Spr2 performs the symmetric rank-two update A += alpha * x * yᵀ + alpha * y * xᵀ
+// for A in packed format.
+func Spr2(ul blas.Uplo, n int, alpha float32, x []float32, incX int, y []float32, incY int, ap []float32) {
+	active.Load().impl.Spr2(ul, n, alpha, x, incX, y, incY, ap)
+}
+
+// Gemm computes C = alpha * op(A) * op(B) + beta * C.
+func Gemm(tA, tB blas.Transpose, m, n, k int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
+	active.Load().impl.Gemm(tA, tB, m, n, k, alpha, a, lda, b, ldb, beta, c, ldc)
+}
+
+// Symm computes C = alpha * A * B + beta * C or C = alpha * B * A + beta * C
+// for a symmetric matrix A.
+func Symm(s blas.Side, ul blas.Uplo, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
+	active.Load().impl.Symm(s, ul, m, n, alpha, a, lda, b, ldb, beta, c, ldc)
+}
+
+// Trmm computes B = alpha * op(A) * B or B = alpha * B * op(A) for a triangular
+// matrix A.
+func Trmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int) {
+	active.Load().impl.Trmm(s, ul, tA, d, m, n, alpha, a, lda, b, ldb)
+}
+
+// Trsm solves op(A) * X = alpha * B or X * op(A) = alpha * B for a triangular
+// matrix A, storing X in place of B.
+func Trsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int) {
+	active.Load().impl.Trsm(s, ul, tA, d, m, n, alpha, a, lda, b, ldb)
+}
+
+// Syrk performs the symmetric rank-k update C = alpha * op(A) * op(A)ᵀ + beta * C.
+func Syrk(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float32, a []float32, lda int, beta float32, c []float32, ldc int) {
+	active.Load().impl.Syrk(ul, tA, n, k, alpha, a, lda, beta, c, ldc)
+}
+
+// Syr2k performs the symmetric rank-2k update
+//
+//	C = alpha * (op(A) * op(B)ᵀ + op(B) * op(A)ᵀ) + beta * C.
+func Syr2k(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
+	active.Load().impl.Syr2k(ul, tA, n, k, alpha, a, lda, b, ldb, beta, c, ldc)
+}
@@ -0,0 +1,25 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo && unix
+
+package blas32
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/cblas/cblasdl"
+)
+
+// The "cblas" backend is a CBLAS shared library found by cblasdl.Load. The
+// library is loaded at run time when the backend is first selected with Use,
+// so binaries are not linked against it and run without it.
+func init() {
+	registerLoader("cblas", func() (blas.Float32, error) {
+		lib, err := cblasdl.Load()
+		if err != nil {
+			return nil, err
+		}
+		return lib.Float32(), nil
+	})
+}
@@ -0,0 +1,35 @@
+//go:build cgo && unix
+
+package blas32
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/gocnn/gomat/cblas/cblasdl"
+)
+
+func TestCblasBackend(t *testing.T) {
+	if !slices.Contains(Backends(), "cblas") {
+		t.Fatalf("Backends() = %v does not contain %q", Backends(), "cblas")
+	}
+	useGo(t)
+
+	// The library in the environment is tried first. Without any other
+	// CBLAS library installed the load fails and the backend is unchanged.
+	t.Setenv(cblasdl.EnvLibrary, filepath.Join(t.TempDir(), "libnothere.so"))
+	if err := Use("cblas"); err != nil {
+		if got := Backend(); got != "go" {
+			t.Errorf("Backend() = %q after a failed load, want %q", got, "go")
+		}
+		return
+	}
+	if got := Backend(); got != "cblas" {
+		t.Errorf("Backend() = %q after Use, want %q", got, "cblas")
+	}
+	x := []float32{1, 2, 3}
+	if got := Dot(3, x, 1, x, 1); got != 14 {
+		t.Errorf("Dot with the cblas backend = %v, want 14", got)
+	}
+}
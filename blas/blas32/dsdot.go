@@ -0,0 +1,66 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blas32
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/internal/mat/f32"
+)
+
+// Sdsdot computes the dot product of the two vectors plus a constant
+//
+//	alpha + \sum_i x[i]*y[i]
+//
+// The dot product is accumulated in double precision.
+func (Implementation) Sdsdot(n int, alpha float32, x []float32, incX int, y []float32, incY int) float32 {
+	return float32(float64(alpha) + dsdot(n, x, incX, y, incY))
+}
+
+// Dsdot computes the dot product of the two vectors
+//
+//	\sum_i x[i]*y[i]
+//
+// The dot product is accumulated and returned in double precision.
+func (Implementation) Dsdot(n int, x []float32, incX int, y []float32, incY int) float64 {
+	return dsdot(n, x, incX, y, incY)
+}
+
+func dsdot(n int, x []float32, incX int, y []float32, incY int) float64 {
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+	if n <= 0 {
+		if n == 0 {
+			return 0
+		}
+		panic(blas.ErrNLT0)
+	}
+	if incX == 1 && incY == 1 {
+		if len(x) < n {
+			panic(blas.ErrShortX)
+		}
+		if len(y) < n {
+			panic(blas.ErrShortY)
+		}
+		return f32.DdotUnitary(x[:n], y[:n])
+	}
+	var ix, iy int
+	if incX < 0 {
+		ix = (-n + 1) * incX
+	}
+	if incY < 0 {
+		iy = (-n + 1) * incY
+	}
+	if ix >= len(x) || ix+(n-1)*incX >= len(x) {
+		panic(blas.ErrShortX)
+	}
+	if iy >= len(y) || iy+(n-1)*incY >= len(y) {
+		panic(blas.ErrShortY)
+	}
+	return f32.DdotInc(x, y, uintptr(n), uintptr(incX), uintptr(incY), uintptr(ix), uintptr(iy))
+}
@@ -0,0 +1,113 @@
+package blas32
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+// refDsdot returns the dot product of the n-element vectors x and y with
+// increments incX and incY, accumulated in float64.
+func refDsdot(n int, x []float32, incX int, y []float32, incY int) float64 {
+	var ix, iy int
+	if incX < 0 {
+		ix = (1 - n) * incX
+	}
+	if incY < 0 {
+		iy = (1 - n) * incY
+	}
+	var sum float64
+	for i := 0; i < n; i++ {
+		sum += float64(x[ix]) * float64(y[iy])
+		ix += incX
+		iy += incY
+	}
+	return sum
+}
+
+func TestDsdot(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 3, 7, 32, 101} {
+		for _, incX := range []int{1, 2, -1, -3} {
+			for _, incY := range []int{1, 3, -1, -2} {
+				x := make([]float32, max(0, 1+(n-1)*max(incX, -incX)))
+				y := make([]float32, max(0, 1+(n-1)*max(incY, -incY)))
+				for i := range x {
+					x[i] = 2*rnd.Float32() - 1
+				}
+				for i := range y {
+					y[i] = 2*rnd.Float32() - 1
+				}
+				want := refDsdot(n, x, incX, y, incY)
+				name := fmt.Sprintf("n=%d,incX=%d,incY=%d", n, incX, incY)
+				if got := Dsdot(n, x, incX, y, incY); math.Abs(got-want) > 1e-14*float64(n) {
+					t.Errorf("%s: Dsdot = %v, want %v", name, got, want)
+				}
+				const alpha = 0.75
+				if got := Sdsdot(n, alpha, x, incX, y, incY); math.Abs(float64(got)-(alpha+want)) > 1e-6*float64(n+1) {
+					t.Errorf("%s: Sdsdot = %v, want %v", name, got, float32(alpha+want))
+				}
+			}
+		}
+	}
+}
+
+func TestDsdotAccumulation(t *testing.T) {
+	// The sum 1e8 + 1 - 1e8 + 1 is 1 in float32 and 2 in float64.
+	x := []float32{1e8, 1, -1e8, 1}
+	y := []float32{1, 1, 1, 1}
+	for _, inc := range []int{1, -1} {
+		if got := Dsdot(len(x), x, inc, y, inc); got != 2 {
+			t.Errorf("inc=%d: Dsdot = %v, want 2", inc, got)
+		}
+		if got := Sdsdot(len(x), 0.5, x, inc, y, inc); got != 2.5 {
+			t.Errorf("inc=%d: Sdsdot = %v, want 2.5", inc, got)
+		}
+	}
+	xs := []float32{1e8, 0, 1, 0, -1e8, 0, 1}
+	if got := Dsdot(len(x), xs, 2, y, 1); got != 2 {
+		t.Errorf("incX=2: Dsdot = %v, want 2", got)
+	}
+}
+
+func TestDsdotPanics(t *testing.T) {
+	x := make([]float32, 4)
+	for _, test := range []struct {
+		name string
+		n    int
+		x    []float32
+		incX int
+		y    []float32
+		incY int
+		want string
+	}{
+		{"n<0", -1, x, 1, x, 1, blas.ErrNLT0},
+		{"incX=0", 2, x, 0, x, 1, blas.ErrZeroIncX},
+		{"incY=0", 2, x, 1, x, 0, blas.ErrZeroIncY},
+		{"short x", 5, x, 1, make([]float32, 5), 1, blas.ErrShortX},
+		{"short y", 5, make([]float32, 5), 1, x, 1, blas.ErrShortY},
+		{"short x,incX=2", 3, x, 2, x, 1, blas.ErrShortX},
+		{"short x,incX=-2", 3, x, -2, x, 1, blas.ErrShortX},
+		{"short y,incY=-2", 3, make([]float32, 5), 2, x, -2, blas.ErrShortY},
+	} {
+		for _, f := range []struct {
+			name string
+			call func()
+		}{
+			{"Dsdot", func() { Dsdot(test.n, test.x, test.incX, test.y, test.incY) }},
+			{"Sdsdot", func() { Sdsdot(test.n, 1, test.x, test.incX, test.y, test.incY) }},
+		} {
+			func() {
+				defer func() {
+					if r := recover(); r != test.want {
+						t.Errorf("%s %s: got panic %v, want %q", f.name, test.name, r, test.want)
+					}
+				}()
+				f.call()
+			}()
+		}
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package blas32
+
+// The extended precision dot products only exist in single precision, so
+// these functions are kept here rather than generated from blas64.
+
+// Sdsdot computes alpha plus the dot product of x and y, accumulated in double
+// precision.
+func Sdsdot(n int, alpha float32, x []float32, incX int, y []float32, incY int) float32 {
+	return active.Load().impl.Sdsdot(n, alpha, x, incX, y, incY)
+}
+
+// Dsdot computes the dot product of x and y, accumulated and returned in double
+// precision.
+func Dsdot(n int, x []float32, incX int, y []float32, incY int) float64 {
+	return active.Load().impl.Dsdot(n, x, incX, y, incY)
+}
@@ -1,5 +1,3 @@
-//go:build !cblas
-
 // Copyright 2015 The Gonum Authors. All rights reserved.
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
@@ -16,7 +14,7 @@ import (
 // Axpy adds alpha times x to y
 //
 //	y[i] += alpha * x[i] for all i
-func Axpy(n int, alpha float32, x []float32, incX int, y []float32, incY int) {
+func (Implementation) Axpy(n int, alpha float32, x []float32, incX int, y []float32, incY int) {
 	if incX == 0 {
 		panic(blas.ErrZeroIncX)
 	}
@@ -57,7 +55,7 @@ func Axpy(n int, alpha float32, x []float32, incX int, y []float32, incY int) {
 //	x[i] *= alpha
 //
 // Scal has no effect if incX < 0.
-func Scal(n int, alpha float32, x []float32, incX int) {
+func (Implementation) Scal(n int, alpha float32, x []float32, incX int) {
 	if incX < 1 {
 		if incX == 0 {
 			panic(blas.ErrZeroIncX)
@@ -96,7 +94,7 @@ func Scal(n int, alpha float32, x []float32, incX int) {
 // Copy copies the elements of x into the elements of y.
 //
 //	y[i] = x[i] for all i
-func Copy(n int, x []float32, incX int, y []float32, incY int) {
+func (Implementation) Copy(n int, x []float32, incX int, y []float32, incY int) {
 	if incX == 0 {
 		panic(blas.ErrZeroIncX)
 	}
@@ -136,7 +134,7 @@ func Copy(n int, x []float32, incX int, y []float32, incY int) {
 // Swap exchanges the elements of two vectors.
 //
 //	x[i], y[i] = y[i], x[i] for all i
-func Swap(n int, x []float32, incX int, y []float32, incY int) {
+func (Implementation) Swap(n int, x []float32, incX int, y []float32, incY int) {
 	if incX == 0 {
 		panic(blas.ErrZeroIncX)
 	}
@@ -179,7 +177,7 @@ func Swap(n int, x []float32, incX int, y []float32, incY int) {
 // Dot computes the dot product of the two vectors
 //
 //	\sum_i x[i]*y[i]
-func Dot(n int, x []float32, incX int, y []float32, incY int) float32 {
+func (Implementation) Dot(n int, x []float32, incX int, y []float32, incY int) float32 {
 	if incX == 0 {
 		panic(blas.ErrZeroIncX)
 	}
@@ -222,7 +220,7 @@ func Dot(n int, x []float32, incX int, y []float32, incY int) float32 {
 //	sqrt(\sum_i x[i] * x[i]).
 //
 // This function returns 0 if incX is negative.
-func Nrm2(n int, x []float32, incX int) float32 {
+func (Implementation) Nrm2(n int, x []float32, incX int) float32 {
 	if incX < 1 {
 		if incX == 0 {
 			panic(blas.ErrZeroIncX)
@@ -252,7 +250,7 @@ func Nrm2(n int, x []float32, incX int) float32 {
 //	\sum_i |x[i]|
 //
 // Asum returns 0 if incX is negative.
-func Asum(n int, x []float32, incX int) float32 {
+func (Implementation) Asum(n int, x []float32, incX int) float32 {
 	var sum float32
 	if n < 0 {
 		panic(blas.ErrNLT0)
@@ -282,7 +280,7 @@ func Asum(n int, x []float32, incX int) float32 {
 // Iamax returns the index of an element of x with the largest absolute value.
 // If there are multiple such indices the earliest is returned.
 // Iamax returns -1 if n == 0.
-func Iamax(n int, x []float32, incX int) int {
+func (Implementation) Iamax(n int, x []float32, incX int) int {
 	if incX < 1 {
 		if incX == 0 {
 			panic(blas.ErrZeroIncX)
@@ -358,7 +356,7 @@ func Iamax(n int, x []float32, incX int) int {
 // BLAS technical manual regarding the sign for r when a or b are zero. Rotg
 // agrees with the definition in the manual and other common BLAS
 // implementations.
-func Rotg(a, b float32) (c, s, r, z float32) {
+func (Implementation) Rotg(a, b float32) (c, s, r, z float32) {
 	// Implementation based on Supplemental Material to:
 	// Edward Anderson. 2017. Algorithm 978: Safe Scaling in the Level 1 BLAS.
 	// ACM Trans. Math. Softw. 44, 1, Article 12 (July 2017), 28 pages.
@@ -410,7 +408,7 @@ func Rotg(a, b float32) (c, s, r, z float32) {
 //
 //	x[i] = c * x[i] + s * y[i]
 //	y[i] = c * y[i] - s * x[i]
-func Rot(n int, x []float32, incX int, y []float32, incY int, c float32, s float32) {
+func (Implementation) Rot(n int, x []float32, incX int, y []float32, incY int, c float32, s float32) {
 	if incX == 0 {
 		panic(blas.ErrZeroIncX)
 	}
@@ -456,7 +454,7 @@ func Rot(n int, x []float32, incX int, y []float32, incY int, c float32, s float
 // Rotmg computes the modified Givens rotation. See
 // http://www.netlib.org/lapack/explore-html/df/deb/drotmg_8f.html
 // for more details.
-func Rotmg(d1, d2, x1, y1 float32) (p blas.SrotmParams, rd1, rd2, rx1 float32) {
+func (Implementation) Rotmg(d1, d2, x1, y1 float32) (p blas.SrotmParams, rd1, rd2, rx1 float32) {
 	// The implementation of Rotmg used here is taken from Hopkins 1997
 	// Appendix A: https://doi.org/10.1145/289251.289253
 	// with the exception of the gam constants below.
@@ -564,7 +562,7 @@ func Rotmg(d1, d2, x1, y1 float32) (p blas.SrotmParams, rd1, rd2, rx1 float32) {
 }
 
 // Rotm applies the modified Givens rotation to the 2×n matrix.
-func Rotm(n int, x []float32, incX int, y []float32, incY int, p blas.SrotmParams) {
+func (Implementation) Rotm(n int, x []float32, incX int, y []float32, incY int, p blas.SrotmParams) {
 	if incX == 0 {
 		panic(blas.ErrZeroIncX)
 	}
@@ -1,5 +1,3 @@
-//go:build !cblas
-
 // Copyright ©2015 The Gonum Authors. All rights reserved.
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
@@ -24,7 +22,7 @@ import (
 // where A is an m×k or k×m dense matrix, B is an n×k or k×n dense matrix, C is
 // an m×n matrix, and alpha and beta are scalars. tA and tB specify whether A or
 // B are transposed.
-func Gemm(tA, tB blas.Transpose, m, n, k int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
+func (Implementation) Gemm(tA, tB blas.Transpose, m, n, k int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
 	switch tA {
 	default:
 		panic(blas.ErrBadTranspose)
@@ -123,8 +121,136 @@ func Gemm(tA, tB blas.Transpose, m, n, k int, alpha float32, a []float32, lda in
 	dgemmParallel(aTrans, bTrans, m, n, k, a, lda, b, ldb, c, ldc, alpha)
 }
 
+// Block sizes of the packed matrix multiplication in dgemmParallel. A
+// gemmMC×gemmKC block of A is packed to stay resident in the L2 cache while it
+// is multiplied by a packed gemmKC×gemmNC block of B, which is streamed from
+// the L3 cache one gemmKC×GemmNR sliver at a time through the L1 cache.
+// gemmMC and gemmNC must be multiples of the block sizes of all the
+// micro-kernels.
+const (
+	gemmMC = 96
+	gemmKC = 256
+	gemmNC = 2048
+)
+
+// gemmMinPacked is the size of m and k below which packing costs more than
+// it saves and dgemmParallel falls back to dgemmTiled. When m is small each
+// packed element of B is used too few times, and when k is small the update of
+// C dominates the micro-kernel.
+const gemmMinPacked = 32
+
+// Pools of packing buffers, so that repeated calls do not allocate.
+var (
+	gemmPoolA = sync.Pool{New: func() any { s := make([]float32, gemmMC*gemmKC); return &s }}
+	gemmPoolB = sync.Pool{New: func() any { s := make([]float32, gemmKC*gemmNC); return &s }}
+)
+
 func dgemmParallel(aTrans, bTrans bool, m, n, k int, a []float32, lda int, b []float32, ldb int, c []float32, ldc int, alpha float32) {
-	// dgemmParallel computes a parallel matrix multiplication by partitioning
+	// dgemmParallel computes the matrix multiplication with the blocked and
+	// packed algorithm of Goto and van de Geijn, using the loop order of BLIS.
+	//
+	//	for jc := 0; jc < n; jc += gemmNC       (columns of B and C)
+	//	  for pc := 0; pc < k; pc += gemmKC     (the shared dimension)
+	//	    pack B[pc:pc+kc, jc:jc+nc] into B̃
+	//	    for ic := 0; ic < m; ic += gemmMC   (rows of A and C, concurrently)
+	//	      pack alpha*A[ic:ic+mc, pc:pc+kc] into Ã
+	//	      for jr := 0; jr < nc; jr += GemmNR
+	//	        for ir := 0; ir < mc; ir += GemmMR
+	//	          C[ic+ir:, jc+jr:] += Ã[ir:ir+GemmMR, :] * B̃[:, jr:jr+GemmNR]
+	//
+	// Packing copies the blocks into contiguous buffers laid out in the order
+	// the micro-kernel reads them, so that all the loads in the innermost loop
+	// are unit stride whatever the transposition of A and B. Each block of C
+	// is updated sequentially along k and the blocks along m are computed
+	// concurrently, so C is updated in place without races. When there are
+	// too few blocks along m to keep all the workers busy, the columns of the
+	// packed B are split between them as well.
+	//
+	// See
+	//  - Goto K., van de Geijn R. A. (2008)
+	//    Anatomy of High-Performance Matrix Multiplication
+	//    ACM Trans Math Softw 34:1--25
+	//  - Van Zee F. G., van de Geijn R. A. (2015)
+	//    BLIS: A Framework for Rapidly Instantiating BLAS Functionality
+	//    ACM Trans Math Softw 41:1--33
+	if m < gemmMinPacked || k < gemmMinPacked {
+		dgemmTiled(aTrans, bTrans, m, n, k, a, lda, b, ldb, c, ldc, alpha)
+		return
+	}
+
+	bufB := gemmPoolB.Get().(*[]float32)
+	defer gemmPoolB.Put(bufB)
+	bp := *bufB
+
+	workers := runtime.GOMAXPROCS(0)
+	for jc := 0; jc < n; jc += gemmNC {
+		nc := min(gemmNC, n-jc)
+		for pc := 0; pc < k; pc += gemmKC {
+			kc := min(gemmKC, k-pc)
+			if bTrans {
+				dpackB(true, kc, nc, b[jc*ldb+pc:], ldb, bp)
+			} else {
+				dpackB(false, kc, nc, b[pc*ldb+jc:], ldb, bp)
+			}
+
+			mBlocks := blas.Blocks(m, gemmMC)
+			nBlocks := 1
+			if mBlocks < workers {
+				nBlocks = min(blas.Blocks(workers, mBlocks), blas.Blocks(nc, f32.GemmNR))
+			}
+			nStep := blas.Blocks(blas.Blocks(nc, nBlocks), f32.GemmNR) * f32.GemmNR
+			nBlocks = blas.Blocks(nc, nStep)
+
+			task := func(ic, jr int) {
+				mc := min(gemmMC, m-ic)
+				ncr := min(nStep, nc-jr)
+				bufA := gemmPoolA.Get().(*[]float32)
+				defer gemmPoolA.Put(bufA)
+				ap := *bufA
+				if aTrans {
+					dpackA(true, mc, kc, alpha, a[pc*lda+ic:], lda, ap)
+				} else {
+					dpackA(false, mc, kc, alpha, a[ic*lda+pc:], lda, ap)
+				}
+				dgemmMacro(mc, ncr, kc, ap, bp[jr*kc:], c[ic*ldc+jc+jr:], ldc)
+			}
+
+			if mBlocks*nBlocks == 1 || workers == 1 {
+				for ic := 0; ic < m; ic += gemmMC {
+					for jr := 0; jr < nc; jr += nStep {
+						task(ic, jr)
+					}
+				}
+				continue
+			}
+
+			// workerLimit acts a number of maximum concurrent workers,
+			// with the limit set to the number of procs available.
+			workerLimit := make(chan struct{}, workers)
+			var wg sync.WaitGroup
+			wg.Add(mBlocks * nBlocks)
+			for ic := 0; ic < m; ic += gemmMC {
+				for jr := 0; jr < nc; jr += nStep {
+					workerLimit <- struct{}{}
+					go func(ic, jr int) {
+						defer func() {
+							wg.Done()
+							<-workerLimit
+						}()
+						task(ic, jr)
+					}(ic, jr)
+				}
+			}
+			// The packed block of B is reused by the next iteration.
+			wg.Wait()
+		}
+	}
+}
+
+// dgemmTiled is the unpacked algorithm used by dgemmParallel when m or k is
+// smaller than gemmMinPacked. It computes 64×64 tiles of C concurrently.
+func dgemmTiled(aTrans, bTrans bool, m, n, k int, a []float32, lda int, b []float32, ldb int, c []float32, ldc int, alpha float32) {
+	// dgemmTiled computes a parallel matrix multiplication by partitioning
 	// a and b into sub-blocks, and updating c with the multiplication of the sub-block
 	// In all cases,
 	// A = [ 	A_11	A_12 ... 	A_1j
@@ -215,6 +341,89 @@ func dgemmParallel(aTrans, bTrans bool, m, n, k int, a []float32, lda int, b []f
 	}
 }
 
+// dpackA packs alpha times the mc×kc block of A, or of Aᵀ if trans is true,
+// whose first element is a[0] into ap. The rows are grouped into panels of
+// GemmMR rows, each stored column by column, and the last panel is padded
+// with zeros.
+func dpackA(trans bool, mc, kc int, alpha float32, a []float32, lda int, ap []float32) {
+	mr := f32.GemmMR
+	for ir := 0; ir < mc; ir += mr {
+		rows := min(mr, mc-ir)
+		panel := ap[ir*kc : (ir+mr)*kc]
+		if rows < mr {
+			clear(panel)
+		}
+		if trans {
+			for p := 0; p < kc; p++ {
+				dst := panel[p*mr : p*mr+rows]
+				for i, v := range a[p*lda+ir : p*lda+ir+rows] {
+					dst[i] = alpha * v
+				}
+			}
+			continue
+		}
+		for i := 0; i < rows; i++ {
+			for p, v := range a[(ir+i)*lda : (ir+i)*lda+kc] {
+				panel[p*mr+i] = alpha * v
+			}
+		}
+	}
+}
+
+// dpackB packs the kc×nc block of B, or of Bᵀ if trans is true, whose first
+// element is b[0] into bp. The columns are grouped into panels of GemmNR
+// columns, each stored row by row, and the last panel is padded with zeros.
+func dpackB(trans bool, kc, nc int, b []float32, ldb int, bp []float32) {
+	nr := f32.GemmNR
+	for jr := 0; jr < nc; jr += nr {
+		cols := min(nr, nc-jr)
+		panel := bp[jr*kc : (jr+nr)*kc]
+		if cols < nr {
+			clear(panel)
+		}
+		if trans {
+			for j := 0; j < cols; j++ {
+				for p, v := range b[(jr+j)*ldb : (jr+j)*ldb+kc] {
+					panel[p*nr+j] = v
+				}
+			}
+			continue
+		}
+		for p := 0; p < kc; p++ {
+			copy(panel[p*nr:p*nr+cols], b[p*ldb+jr:p*ldb+jr+cols])
+		}
+	}
+}
+
+// dgemmMacro computes C += Ã * B̃ for the mc×nc block of C whose first element
+// is c[0], where ap and bp hold the packed mc×kc block of A and kc×nc block
+// of B. Blocks at the bottom and right edges of C that are smaller than the
+// micro-kernel are computed into a temporary and added to C.
+func dgemmMacro(mc, nc, kc int, ap, bp, c []float32, ldc int) {
+	mr, nr := f32.GemmMR, f32.GemmNR
+	var tile [f32.GemmMaxMR * f32.GemmMaxNR]float32
+	for jr := 0; jr < nc; jr += nr {
+		cols := min(nr, nc-jr)
+		b := bp[jr*kc:]
+		for ir := 0; ir < mc; ir += mr {
+			rows := min(mr, mc-ir)
+			a := ap[ir*kc:]
+			if rows == mr && cols == nr {
+				f32.GemmKernel(kc, a, b, c[ir*ldc+jr:], ldc)
+				continue
+			}
+			clear(tile[:mr*nr])
+			f32.GemmKernel(kc, a, b, tile[:], nr)
+			for i := 0; i < rows; i++ {
+				ctmp := c[(ir+i)*ldc+jr : (ir+i)*ldc+jr+cols]
+				for j, v := range tile[i*nr : i*nr+cols] {
+					ctmp[j] += v
+				}
+			}
+		}
+	}
+}
+
 // dgemmSerial is serial matrix multiply
 func dgemmSerial(aTrans, bTrans bool, m, n, k int, a []float32, lda int, b []float32, ldb int, c []float32, ldc int, alpha float32) {
 	switch {
@@ -305,7 +514,7 @@ func sliceView64(a []float32, lda, i, j, r, c int) []float32 {
 //
 // where A is an n×n or m×m symmetric matrix, B and C are m×n matrices, and alpha
 // is a scalar.
-func Symm(s blas.Side, ul blas.Uplo, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
+func (Implementation) Symm(s blas.Side, ul blas.Uplo, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
 	if s != blas.Right && s != blas.Left {
 		panic(blas.ErrBadSide)
 	}
@@ -451,7 +660,7 @@ func Symm(s blas.Side, ul blas.Uplo, m, n int, alpha float32, a []float32, lda i
 //	B = alpha * B * Aᵀ  if tA == blas.Trans or blas.ConjTrans, and side == blas.Right
 //
 // where A is an n×n or m×m triangular matrix, B is an m×n matrix, and alpha is a scalar.
-func Trmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int) {
+func (Implementation) Trmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int) {
 	if s != blas.Left && s != blas.Right {
 		panic(blas.ErrBadSide)
 	}
@@ -655,7 +864,7 @@ func Trmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, a
 // stored in-place into X.
 //
 // No check is made that A is invertible.
-func Trsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int) {
+func (Implementation) Trsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int) {
 	if s != blas.Left && s != blas.Right {
 		panic(blas.ErrBadSide)
 	}
@@ -853,7 +1062,7 @@ func Trsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, a
 //
 // where A is an n×k or k×n matrix, C is an n×n symmetric matrix, and alpha and
 // beta are scalars.
-func Syrk(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float32, a []float32, lda int, beta float32, c []float32, ldc int) {
+func (Implementation) Syrk(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float32, a []float32, lda int, beta float32, c []float32, ldc int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(blas.ErrBadUplo)
 	}
@@ -1005,7 +1214,7 @@ func Syrk(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float32, a []float32,
 //
 // where A and B are n×k or k×n matrices, C is an n×n symmetric matrix, and
 // alpha and beta are scalars.
-func Syr2k(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
+func (Implementation) Syr2k(ul blas.Uplo, tA blas.Transpose, n, k int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(blas.ErrBadUplo)
 	}
@@ -0,0 +1,139 @@
+package blas64
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+// constDot is the pure Go implementation with a Dot that returns a constant,
+// so that the tests can tell which backend was called.
+type constDot struct {
+	Implementation
+}
+
+func (constDot) Dot(n int, x []float64, incX int, y []float64, incY int) float64 {
+	return 42
+}
+
+// useGo restores the default backend at the end of a test.
+func useGo(t *testing.T) {
+	t.Cleanup(func() {
+		if err := Use("go"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+// unregister removes the named backend at the end of a test.
+func unregister(t *testing.T, name string) {
+	t.Cleanup(func() {
+		mu.Lock()
+		defer mu.Unlock()
+		delete(backends, name)
+	})
+}
+
+func panics(f func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	f()
+	return false
+}
+
+func TestDefaultBackend(t *testing.T) {
+	if got := Backend(); got != "go" {
+		t.Errorf("default backend is %q, want %q", got, "go")
+	}
+	if !slices.Contains(Backends(), "go") {
+		t.Errorf("Backends() = %v does not contain %q", Backends(), "go")
+	}
+	if !slices.IsSorted(Backends()) {
+		t.Errorf("Backends() = %v is not sorted", Backends())
+	}
+}
+
+func TestUse(t *testing.T) {
+	useGo(t)
+	unregister(t, "const")
+	Register("const", constDot{})
+	if !slices.Contains(Backends(), "const") {
+		t.Errorf("Backends() = %v does not contain the registered backend", Backends())
+	}
+
+	x := []float64{1, 2}
+	if got := Dot(2, x, 1, x, 1); got != 5 {
+		t.Errorf("Dot with the go backend = %v, want 5", got)
+	}
+	if err := Use("const"); err != nil {
+		t.Fatal(err)
+	}
+	if got := Backend(); got != "const" {
+		t.Errorf("Backend() = %q after Use, want %q", got, "const")
+	}
+	if got := Dot(2, x, 1, x, 1); got != 42 {
+		t.Errorf("Dot with the const backend = %v, want 42", got)
+	}
+
+	if err := Use("unknown"); err == nil {
+		t.Error("no error for an unknown backend")
+	}
+	if got := Backend(); got != "const" {
+		t.Errorf("Backend() = %q after a failed Use, want %q", got, "const")
+	}
+}
+
+func TestRegisterPanics(t *testing.T) {
+	if !panics(func() { Register("go", Implementation{}) }) {
+		t.Error("no panic for a duplicate name")
+	}
+	if !panics(func() { Register("nil", nil) }) {
+		t.Error("no panic for a nil implementation")
+	}
+	if !panics(func() {
+		registerLoader("go", func() (blas.Float64, error) { return Implementation{}, nil })
+	}) {
+		t.Error("no panic for a duplicate loader name")
+	}
+}
+
+func TestRegisterLoader(t *testing.T) {
+	useGo(t)
+	unregister(t, "lazy")
+	var calls int
+	errLoad := errors.New("no library")
+	registerLoader("lazy", func() (blas.Float64, error) {
+		calls++
+		if calls == 1 {
+			return nil, errLoad
+		}
+		return constDot{}, nil
+	})
+	if calls != 0 {
+		t.Errorf("loader called %d times by registration", calls)
+	}
+
+	err := Use("lazy")
+	if !errors.Is(err, errLoad) {
+		t.Errorf("Use returned %v, want the load error", err)
+	}
+	if got := Backend(); got != "go" {
+		t.Errorf("Backend() = %q after a failed load, want %q", got, "go")
+	}
+
+	// A failed load is retried, and a successful one is kept.
+	for i := 0; i < 2; i++ {
+		if err := Use("lazy"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("loader called %d times, want 2", calls)
+	}
+	if got := Dot(1, []float64{1}, 1, []float64{1}, 1); got != 42 {
+		t.Errorf("Dot with the loaded backend = %v, want 42", got)
+	}
+}
@@ -1,5 +1,3 @@
-//go:build !cblas
-
 // Copyright ©2015 The Gonum Authors. All rights reserved.
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
@@ -17,7 +15,7 @@ import (
 //	y = alpha * Aᵀ * x + beta * y  if tA = blas.Trans or blas.ConjTrans
 //
 // where A is an m×n dense matrix, x and y are vectors, and alpha and beta are scalars.
-func Gemv(tA blas.Transpose, m, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+func (Implementation) Gemv(tA blas.Transpose, m, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
 	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
 		panic(blas.ErrBadTranspose)
 	}
@@ -67,9 +65,9 @@ func Gemv(tA blas.Transpose, m, n int, alpha float64, a []float64, lda int, x []
 	if alpha == 0 {
 		// First form y = beta * y
 		if incY > 0 {
-			Scal(lenY, beta, y, incY)
+			Implementation{}.Scal(lenY, beta, y, incY)
 		} else {
-			Scal(lenY, beta, y, -incY)
+			Implementation{}.Scal(lenY, beta, y, -incY)
 		}
 		return
 	}
@@ -89,7 +87,7 @@ func Gemv(tA blas.Transpose, m, n int, alpha float64, a []float64, lda int, x []
 //
 // where A is an n×n symmetric matrix, x and y are vectors, and alpha and
 // beta are scalars.
-func Symv(ul blas.Uplo, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+func (Implementation) Symv(ul blas.Uplo, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(blas.ErrBadUplo)
 	}
@@ -259,7 +257,7 @@ func Symv(ul blas.Uplo, n int, alpha float64, a []float64, lda int, x []float64,
 //	x = Aᵀ * x  if tA == blas.Trans or blas.ConjTrans
 //
 // where A is an n×n triangular matrix, and x is a vector.
-func Trmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float64, lda int, x []float64, incX int) {
+func (Implementation) Trmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float64, lda int, x []float64, incX int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(blas.ErrBadUplo)
 	}
@@ -419,7 +417,7 @@ func Trmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float64, lda
 //
 // No test for singularity or near-singularity is included in this
 // routine. Such tests must be performed before calling this routine.
-func Trsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float64, lda int, x []float64, incX int) {
+func (Implementation) Trsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float64, lda int, x []float64, incX int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(blas.ErrBadUplo)
 	}
@@ -595,7 +593,7 @@ func Trsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float64, lda
 //	A += alpha * x * yᵀ
 //
 // where A is an m×n dense matrix, x and y are vectors, and alpha is a scalar.
-func Ger(m, n int, alpha float64, x []float64, incX int, y []float64, incY int, a []float64, lda int) {
+func (Implementation) Ger(m, n int, alpha float64, x []float64, incX int, y []float64, incY int, a []float64, lda int) {
 	if m < 0 {
 		panic(blas.ErrMLT0)
 	}
@@ -644,7 +642,7 @@ func Ger(m, n int, alpha float64, x []float64, incX int, y []float64, incY int,
 //	A += alpha * x * xᵀ
 //
 // where A is an n×n symmetric matrix, and x is a vector.
-func Syr(ul blas.Uplo, n int, alpha float64, x []float64, incX int, a []float64, lda int) {
+func (Implementation) Syr(ul blas.Uplo, n int, alpha float64, x []float64, incX int, a []float64, lda int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(blas.ErrBadUplo)
 	}
@@ -744,7 +742,7 @@ func Syr(ul blas.Uplo, n int, alpha float64, x []float64, incX int, a []float64,
 //	A += alpha * x * yᵀ + alpha * y * xᵀ
 //
 // where A is an n×n symmetric matrix, x and y are vectors, and alpha is a scalar.
-func Syr2(ul blas.Uplo, n int, alpha float64, x []float64, incX int, y []float64, incY int, a []float64, lda int) {
+func (Implementation) Syr2(ul blas.Uplo, n int, alpha float64, x []float64, incX int, y []float64, incY int, a []float64, lda int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(blas.ErrBadUplo)
 	}
@@ -855,7 +853,7 @@ func Syr2(ul blas.Uplo, n int, alpha float64, x []float64, incX int, y []float64
 //
 // where A is an m×n band matrix with kL sub-diagonals and kU super-diagonals,
 // x and y are vectors, and alpha and beta are scalars.
-func Gbmv(tA blas.Transpose, m, n, kL, kU int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+func (Implementation) Gbmv(tA blas.Transpose, m, n, kL, kU int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
 	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
 		panic(blas.ErrBadTranspose)
 	}
@@ -1021,7 +1019,7 @@ func Gbmv(tA blas.Transpose, m, n, kL, kU int, alpha float64, a []float64, lda i
 //
 // where A is an n×n symmetric band matrix with k super-diagonals, x and y are
 // vectors, and alpha and beta are scalars.
-func Sbmv(ul blas.Uplo, n, k int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+func (Implementation) Sbmv(ul blas.Uplo, n, k int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(blas.ErrBadUplo)
 	}
@@ -1193,7 +1191,7 @@ func Sbmv(ul blas.Uplo, n, k int, alpha float64, a []float64, lda int, x []float
 //	x = Aᵀ * x  if tA == blas.Trans or blas.ConjTrans
 //
 // where A is an n×n triangular band matrix with k+1 diagonals, and x is a vector.
-func Tbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []float64, lda int, x []float64, incX int) {
+func (Implementation) Tbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []float64, lda int, x []float64, incX int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(blas.ErrBadUplo)
 	}
@@ -1411,7 +1409,7 @@ func Tbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []float64, l
 //
 // No test for singularity or near-singularity is included in this
 // routine. Such tests must be performed before calling this routine.
-func Tbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []float64, lda int, x []float64, incX int) {
+func (Implementation) Tbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []float64, lda int, x []float64, incX int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(blas.ErrBadUplo)
 	}
@@ -1628,7 +1626,7 @@ func Tbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []float64, l
 //
 // where A is an n×n symmetric matrix in packed format, x and y are vectors,
 // and alpha and beta are scalars.
-func Spmv(ul blas.Uplo, n int, alpha float64, ap []float64, x []float64, incX int, beta float64, y []float64, incY int) {
+func (Implementation) Spmv(ul blas.Uplo, n int, alpha float64, ap []float64, x []float64, incX int, beta float64, y []float64, incY int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(blas.ErrBadUplo)
 	}
@@ -1797,7 +1795,7 @@ func Spmv(ul blas.Uplo, n int, alpha float64, ap []float64, x []float64, incX in
 //	x = Aᵀ * x  if tA == blas.Trans or blas.ConjTrans
 //
 // where A is an n×n triangular matrix in packed format, and x is a vector.
-func Tpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap []float64, x []float64, incX int) {
+func (Implementation) Tpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap []float64, x []float64, incX int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(blas.ErrBadUplo)
 	}
@@ -1984,7 +1982,7 @@ func Tpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap []float64, x [
 //
 // No test for singularity or near-singularity is included in this
 // routine. Such tests must be performed before calling this routine.
-func Tpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap []float64, x []float64, incX int) {
+func (Implementation) Tpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap []float64, x []float64, incX int) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(blas.ErrBadUplo)
 	}
@@ -2164,7 +2162,7 @@ func Tpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap []float64, x [
 //
 // where A is an n×n symmetric matrix in packed format, x is a vector, and
 // alpha is a scalar.
-func Spr(ul blas.Uplo, n int, alpha float64, x []float64, incX int, ap []float64) {
+func (Implementation) Spr(ul blas.Uplo, n int, alpha float64, x []float64, incX int, ap []float64) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(blas.ErrBadUplo)
 	}
@@ -2258,7 +2256,7 @@ func Spr(ul blas.Uplo, n int, alpha float64, x []float64, incX int, ap []float64
 //
 // where A is an n×n symmetric matrix in packed format, x and y are vectors,
 // and alpha is a scalar.
-func Spr2(ul blas.Uplo, n int, alpha float64, x []float64, incX int, y []float64, incY int, ap []float64) {
+func (Implementation) Spr2(ul blas.Uplo, n int, alpha float64, x []float64, incX int, y []float64, incY int, ap []float64) {
 	if ul != blas.Lower && ul != blas.Upper {
 		panic(blas.ErrBadUplo)
 	}
@@ -0,0 +1,105 @@
+package blas64
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"runtime"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+// gemmTestShapes are the m×n×k sizes of TestGemm. They are not multiples of
+// the micro-kernel or cache block sizes, and cover both the packed algorithm
+// and the tiled one used when m or k is small.
+var gemmTestShapes = [][3]int{
+	{0, 0, 0},
+	{1, 1, 1},
+	{3, 5, 7},
+	{16, 300, 100},
+	{200, 70, 16},
+	{33, 35, 37},
+	{97, 130, 259},
+	{193, 9, 45},
+	{35, 2051, 33},
+}
+
+// naiveGemm computes C = alpha*op(A)*op(B) + beta*C with a triple loop.
+func naiveGemm(aTrans, bTrans bool, m, n, k int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			var sum float64
+			for l := 0; l < k; l++ {
+				var ail, blj float64
+				if aTrans {
+					ail = a[l*lda+i]
+				} else {
+					ail = a[i*lda+l]
+				}
+				if bTrans {
+					blj = b[j*ldb+l]
+				} else {
+					blj = b[l*ldb+j]
+				}
+				sum += ail * blj
+			}
+			c[i*ldc+j] = alpha*sum + beta*c[i*ldc+j]
+		}
+	}
+}
+
+func TestGemm(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(0))
+	for _, procs := range []int{1, 4} {
+		runtime.GOMAXPROCS(procs)
+		for _, sh := range gemmTestShapes {
+			m, n, k := sh[0], sh[1], sh[2]
+			for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				for _, tB := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+					for _, ab := range [][2]float64{{1, 1}, {-0.5, 0}, {2, 0.5}} {
+						alpha, beta := ab[0], ab[1]
+						aTrans, bTrans := tA == blas.Trans, tB == blas.Trans
+						ra, ca := m, k
+						if aTrans {
+							ra, ca = k, m
+						}
+						rb, cb := k, n
+						if bTrans {
+							rb, cb = n, k
+						}
+						lda, ldb, ldc := ca+3, cb+2, n+1
+						a := randSlice(rnd, max(1, ra*lda))
+						b := randSlice(rnd, max(1, rb*ldb))
+						c := randSlice(rnd, max(1, m*ldc))
+						want := append([]float64(nil), c...)
+
+						Implementation{}.Gemm(tA, tB, m, n, k, alpha, a, lda, b, ldb, beta, c, ldc)
+						naiveGemm(aTrans, bTrans, m, n, k, alpha, a, lda, b, ldb, beta, want, ldc)
+
+						name := fmt.Sprintf("procs=%d,m=%d,n=%d,k=%d,tA=%c,tB=%c,alpha=%v,beta=%v", procs, m, n, k, tA, tB, alpha, beta)
+						tol := 1e-14 * float64(k+1)
+					loop:
+						for i := 0; i < m; i++ {
+							for j := 0; j < ldc; j++ {
+								got, w := c[i*ldc+j], want[i*ldc+j]
+								if j >= n {
+									if got != w {
+										t.Errorf("%s: element (%d,%d) outside C modified", name, i, j)
+										break loop
+									}
+									continue
+								}
+								if math.Abs(got-w) > tol*max(math.Abs(w), 1) {
+									t.Errorf("%s: C[%d,%d] = %v, want %v", name, i, j, got, w)
+									break loop
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package c128
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+// The tests in this file check the implementation against straightforward
+// reference loops.
+
+var transposes = []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans}
+
+// incs are the vector increments of the level 1 and level 2 tests.
+var incs = []int{1, 2, -1, -3}
+
+func randomSlice(rnd *rand.Rand, n int) []complex128 {
+	s := make([]complex128, n)
+	for i := range s {
+		s[i] = complex(2*rnd.Float64()-1, 2*rnd.Float64()-1)
+	}
+	return s
+}
+
+// vecLen returns the length of a vector of n elements with increment inc.
+func vecLen(n, inc int) int {
+	if n == 0 {
+		return 0
+	}
+	return 1 + (n-1)*abs(inc)
+}
+
+// vecIndex returns the index in a vector of n elements with increment inc of
+// its ith element.
+func vecIndex(i, n, inc int) int {
+	if inc < 0 {
+		return (i - n + 1) * inc
+	}
+	return i * inc
+}
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// op returns the element (i, j) of op(A) for the matrix a with row stride lda.
+func op(t blas.Transpose, a []complex128, lda, i, j int) complex128 {
+	switch t {
+	case blas.Trans:
+		return a[j*lda+i]
+	case blas.ConjTrans:
+		return cmplx.Conj(a[j*lda+i])
+	}
+	return a[i*lda+j]
+}
+
+// near reports whether a and b are equal to within a tolerance relative to
+// scale.
+func near(a, b complex128, scale float64) bool {
+	return cmplx.Abs(a-b) <= 1e-13*max(scale, 1)
+}
+
+func TestDot(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 3, 7, 32, 101} {
+		for _, incX := range incs {
+			for _, incY := range incs {
+				x := randomSlice(rnd, vecLen(n, incX))
+				y := randomSlice(rnd, vecLen(n, incY))
+				var wantc, wantu complex128
+				for i := 0; i < n; i++ {
+					xi, yi := x[vecIndex(i, n, incX)], y[vecIndex(i, n, incY)]
+					wantc += cmplx.Conj(xi) * yi
+					wantu += xi * yi
+				}
+				name := fmt.Sprintf("n=%d,incX=%d,incY=%d", n, incX, incY)
+				if got := Dotc(n, x, incX, y, incY); !near(got, wantc, float64(n)) {
+					t.Errorf("%s: Dotc = %v, want %v", name, got, wantc)
+				}
+				if got := Dotu(n, x, incX, y, incY); !near(got, wantu, float64(n)) {
+					t.Errorf("%s: Dotu = %v, want %v", name, got, wantu)
+				}
+			}
+		}
+	}
+}
+
+func TestNrm2(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 3, 7, 32, 101} {
+		for _, inc := range []int{1, 2, 3} {
+			// The huge and tiny scales check that the sum of squares
+			// does not overflow or underflow.
+			for _, scale := range []float64{1, 1e300, 1e-300} {
+				x := randomSlice(rnd, vecLen(n, inc))
+				var sum float64
+				for i := 0; i < n; i++ {
+					v := x[i*inc]
+					sum += real(v)*real(v) + imag(v)*imag(v)
+					x[i*inc] *= complex(scale, 0)
+				}
+				want := scale * math.Sqrt(sum)
+				got := Nrm2(n, x, inc)
+				if math.Abs(got-want) > 1e-14*want {
+					t.Errorf("n=%d,inc=%d,scale=%v: Nrm2 = %v, want %v", n, inc, scale, got, want)
+				}
+			}
+		}
+	}
+	if got := Nrm2(2, []complex128{1, 1}, -1); got != 0 {
+		t.Errorf("Nrm2 with a negative increment = %v, want 0", got)
+	}
+}
+
+func TestGemv(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{{0, 0}, {1, 1}, {3, 5}, {5, 3}, {17, 33}} {
+		m, n := dims[0], dims[1]
+		for _, trans := range transposes {
+			for _, inc := range incs {
+				lenX, lenY := n, m
+				if trans != blas.NoTrans {
+					lenX, lenY = m, n
+				}
+				const alpha, beta = 2 - 0.5i, 0.5 + 1i
+				lda := n + 2
+				a := randomSlice(rnd, max(0, (m-1)*lda+n))
+				x := randomSlice(rnd, vecLen(lenX, inc))
+				y := randomSlice(rnd, vecLen(lenY, inc))
+				want := make([]complex128, len(y))
+				copy(want, y)
+				for i := 0; i < lenY; i++ {
+					var sum complex128
+					for j := 0; j < lenX; j++ {
+						sum += op(trans, a, lda, i, j) * x[vecIndex(j, lenX, inc)]
+					}
+					iy := vecIndex(i, lenY, inc)
+					want[iy] = alpha*sum + beta*y[iy]
+				}
+
+				Gemv(trans, m, n, alpha, a, lda, x, inc, beta, y, inc)
+				name := fmt.Sprintf("m=%d,n=%d,trans=%c,inc=%d", m, n, trans, inc)
+				for i := range y {
+					if !near(y[i], want[i], float64(max(m, n))) {
+						t.Errorf("%s: y[%d] = %v, want %v", name, i, y[i], want[i])
+						break
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestGemm(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][3]int{{0, 0, 0}, {1, 1, 1}, {3, 4, 5}, {5, 4, 3}, {20, 30, 40}, {65, 33, 70}} {
+		m, n, k := dims[0], dims[1], dims[2]
+		for _, tA := range transposes {
+			for _, tB := range transposes {
+				for _, beta := range []complex128{0, 1, 0.5 - 2i} {
+					const alpha = -1 + 0.5i
+					ra, ca := m, k
+					if tA != blas.NoTrans {
+						ra, ca = k, m
+					}
+					rb, cb := k, n
+					if tB != blas.NoTrans {
+						rb, cb = n, k
+					}
+					lda, ldb, ldc := ca+1, cb+2, n+3
+					a := randomSlice(rnd, max(0, (ra-1)*lda+ca))
+					b := randomSlice(rnd, max(0, (rb-1)*ldb+cb))
+					c := randomSlice(rnd, max(0, (m-1)*ldc+n))
+					want := make([]complex128, len(c))
+					copy(want, c)
+					for i := 0; i < m; i++ {
+						for j := 0; j < n; j++ {
+							var sum complex128
+							for l := 0; l < k; l++ {
+								sum += op(tA, a, lda, i, l) * op(tB, b, ldb, l, j)
+							}
+							want[i*ldc+j] = alpha*sum + beta*c[i*ldc+j]
+						}
+					}
+
+					Gemm(tA, tB, m, n, k, alpha, a, lda, b, ldb, beta, c, ldc)
+					name := fmt.Sprintf("m=%d,n=%d,k=%d,tA=%c,tB=%c,beta=%v", m, n, k, tA, tB, beta)
+					for i := range c {
+						if !near(c[i], want[i], float64(k)) {
+							t.Errorf("%s: c[%d] = %v, want %v", name, i, c[i], want[i])
+							break
+						}
+					}
+				}
+			}
+		}
+	}
+}
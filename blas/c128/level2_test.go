@@ -0,0 +1,377 @@
+package c128
+
+import (
+	"fmt"
+	"math/cmplx"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+var (
+	uplos = []blas.Uplo{blas.Upper, blas.Lower}
+	diags = []blas.Diag{blas.NonUnit, blas.Unit}
+)
+
+// storage returns the index in a slice of the element (i, j) of a stored
+// triangle, or -1 if the element lies outside a band.
+type storage func(i, j int) int
+
+func dense(lda int) storage {
+	return func(i, j int) int { return i*lda + j }
+}
+
+func band(uplo blas.Uplo, k, lda int) storage {
+	return func(i, j int) int {
+		if uplo == blas.Upper {
+			if j-i > k {
+				return -1
+			}
+			return i*lda + j - i
+		}
+		if i-j > k {
+			return -1
+		}
+		return i*lda + k + j - i
+	}
+}
+
+func packed(uplo blas.Uplo, n int) storage {
+	return func(i, j int) int {
+		if uplo == blas.Upper {
+			return i*n - i*(i-1)/2 + j - i
+		}
+		return i*(i+1)/2 + j
+	}
+}
+
+// inTriangle reports whether the element (i, j) lies in the uplo triangle.
+func inTriangle(uplo blas.Uplo, i, j int) bool {
+	if uplo == blas.Upper {
+		return i <= j
+	}
+	return i >= j
+}
+
+// hermitian returns, with row stride n, the n×n Hermitian matrix whose uplo
+// triangle is stored in a. The imaginary parts of the diagonal are ignored.
+func hermitian(uplo blas.Uplo, n int, a []complex128, idx storage) []complex128 {
+	h := make([]complex128, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			p := idx(i, j)
+			if !inTriangle(uplo, i, j) || p < 0 {
+				continue
+			}
+			if i == j {
+				h[i*n+i] = complex(real(a[p]), 0)
+				continue
+			}
+			h[i*n+j] = a[p]
+			h[j*n+i] = cmplx.Conj(a[p])
+		}
+	}
+	return h
+}
+
+// triangular returns, with row stride n, the n×n triangular matrix whose uplo
+// triangle is stored in a.
+func triangular(uplo blas.Uplo, diag blas.Diag, n int, a []complex128, idx storage) []complex128 {
+	t := make([]complex128, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			p := idx(i, j)
+			if !inTriangle(uplo, i, j) || p < 0 {
+				continue
+			}
+			t[i*n+j] = a[p]
+		}
+		if diag == blas.Unit {
+			t[i*n+i] = 1
+		}
+	}
+	return t
+}
+
+// makeDominant scales the stored uplo triangle of a so that the triangular
+// matrix it holds is diagonally dominant and well conditioned.
+func makeDominant(uplo blas.Uplo, n int, a []complex128, idx storage) {
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			p := idx(i, j)
+			if !inTriangle(uplo, i, j) || p < 0 {
+				continue
+			}
+			if i == j {
+				a[p] += 2
+			} else {
+				a[p] /= complex(float64(n), 0)
+			}
+		}
+	}
+}
+
+// refGemv returns a copy of y in which the vector y has been replaced by
+// alpha*op(A)*x + beta*y.
+func refGemv(trans blas.Transpose, m, n int, alpha complex128, a []complex128, lda int, x []complex128, incX int, beta complex128, y []complex128, incY int) []complex128 {
+	lenX, lenY := n, m
+	if trans != blas.NoTrans {
+		lenX, lenY = m, n
+	}
+	want := slices.Clone(y)
+	for i := 0; i < lenY; i++ {
+		var sum complex128
+		for j := 0; j < lenX; j++ {
+			sum += op(trans, a, lda, i, j) * x[vecIndex(j, lenX, incX)]
+		}
+		iy := vecIndex(i, lenY, incY)
+		want[iy] = alpha*sum + beta*y[iy]
+	}
+	return want
+}
+
+// checkSlices reports an error if got and want differ by more than a
+// tolerance relative to scale.
+func checkSlices(t *testing.T, name string, got, want []complex128, scale float64) {
+	t.Helper()
+	for i := range got {
+		if !near(got[i], want[i], scale) {
+			t.Errorf("%s: element %d = %v, want %v", name, i, got[i], want[i])
+			return
+		}
+	}
+}
+
+var (
+	level2Sizes = []int{0, 1, 2, 3, 7, 17}
+	bandwidths  = []int{0, 1, 3}
+)
+
+func TestHemv(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range uplos {
+		for _, n := range level2Sizes {
+			for _, incX := range incs {
+				for _, incY := range incs {
+					const alpha, beta = 2 - 0.5i, 0.5 + 1i
+					lda := n + 2
+					a := randomSlice(rnd, max(0, (n-1)*lda+n))
+					x := randomSlice(rnd, vecLen(n, incX))
+					y := randomSlice(rnd, vecLen(n, incY))
+					h := hermitian(uplo, n, a, dense(lda))
+					want := refGemv(blas.NoTrans, n, n, alpha, h, max(1, n), x, incX, beta, y, incY)
+
+					Hemv(uplo, n, alpha, a, lda, x, incX, beta, y, incY)
+					name := fmt.Sprintf("uplo=%c,n=%d,incX=%d,incY=%d", uplo, n, incX, incY)
+					checkSlices(t, name, y, want, float64(n))
+				}
+			}
+		}
+	}
+}
+
+func TestHbmv(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range uplos {
+		for _, n := range level2Sizes {
+			for _, k := range bandwidths {
+				for _, inc := range incs {
+					const alpha, beta = 2 - 0.5i, 0.5 + 1i
+					lda := k + 3
+					a := randomSlice(rnd, max(0, (n-1)*lda+k+1))
+					x := randomSlice(rnd, vecLen(n, inc))
+					y := randomSlice(rnd, vecLen(n, -inc))
+					h := hermitian(uplo, n, a, band(uplo, k, lda))
+					want := refGemv(blas.NoTrans, n, n, alpha, h, max(1, n), x, inc, beta, y, -inc)
+
+					Hbmv(uplo, n, k, alpha, a, lda, x, inc, beta, y, -inc)
+					name := fmt.Sprintf("uplo=%c,n=%d,k=%d,inc=%d", uplo, n, k, inc)
+					checkSlices(t, name, y, want, float64(n))
+				}
+			}
+		}
+	}
+}
+
+func TestHpmv(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range uplos {
+		for _, n := range level2Sizes {
+			for _, incX := range incs {
+				for _, incY := range incs {
+					const alpha, beta = 2 - 0.5i, 0.5 + 1i
+					ap := randomSlice(rnd, n*(n+1)/2)
+					x := randomSlice(rnd, vecLen(n, incX))
+					y := randomSlice(rnd, vecLen(n, incY))
+					h := hermitian(uplo, n, ap, packed(uplo, n))
+					want := refGemv(blas.NoTrans, n, n, alpha, h, max(1, n), x, incX, beta, y, incY)
+
+					Hpmv(uplo, n, alpha, ap, x, incX, beta, y, incY)
+					name := fmt.Sprintf("uplo=%c,n=%d,incX=%d,incY=%d", uplo, n, incX, incY)
+					checkSlices(t, name, y, want, float64(n))
+				}
+			}
+		}
+	}
+}
+
+// hermitianUpdate returns a copy of the n×n matrix a in which the uplo
+// triangle has been updated by the Hermitian matrix with elements p(i, j), and
+// the imaginary parts of its diagonal have been set to zero.
+func hermitianUpdate(uplo blas.Uplo, n int, a []complex128, lda int, p func(i, j int) complex128) []complex128 {
+	want := slices.Clone(a)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if !inTriangle(uplo, i, j) {
+				continue
+			}
+			v := a[i*lda+j] + p(i, j)
+			if i == j {
+				v = complex(real(v), 0)
+			}
+			want[i*lda+j] = v
+		}
+	}
+	return want
+}
+
+func TestHer(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range uplos {
+		for _, n := range level2Sizes {
+			for _, inc := range incs {
+				const alpha = -0.7
+				lda := n + 2
+				a := randomSlice(rnd, max(0, (n-1)*lda+n))
+				x := randomSlice(rnd, vecLen(n, inc))
+				want := hermitianUpdate(uplo, n, a, lda, func(i, j int) complex128 {
+					return alpha * x[vecIndex(i, n, inc)] * cmplx.Conj(x[vecIndex(j, n, inc)])
+				})
+
+				Her(uplo, n, alpha, x, inc, a, lda)
+				name := fmt.Sprintf("uplo=%c,n=%d,inc=%d", uplo, n, inc)
+				checkSlices(t, name, a, want, 1)
+			}
+		}
+	}
+}
+
+func TestHer2(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range uplos {
+		for _, n := range level2Sizes {
+			for _, incX := range incs {
+				for _, incY := range incs {
+					const alpha = 2 - 0.5i
+					lda := n + 2
+					a := randomSlice(rnd, max(0, (n-1)*lda+n))
+					x := randomSlice(rnd, vecLen(n, incX))
+					y := randomSlice(rnd, vecLen(n, incY))
+					want := hermitianUpdate(uplo, n, a, lda, func(i, j int) complex128 {
+						xi, xj := x[vecIndex(i, n, incX)], x[vecIndex(j, n, incX)]
+						yi, yj := y[vecIndex(i, n, incY)], y[vecIndex(j, n, incY)]
+						return alpha*xi*cmplx.Conj(yj) + cmplx.Conj(alpha)*yi*cmplx.Conj(xj)
+					})
+
+					Her2(uplo, n, alpha, x, incX, y, incY, a, lda)
+					name := fmt.Sprintf("uplo=%c,n=%d,incX=%d,incY=%d", uplo, n, incX, incY)
+					checkSlices(t, name, a, want, 1)
+				}
+			}
+		}
+	}
+}
+
+func TestTrmv(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range uplos {
+		for _, trans := range transposes {
+			for _, diag := range diags {
+				for _, n := range level2Sizes {
+					for _, inc := range incs {
+						lda := n + 2
+						a := randomSlice(rnd, max(0, (n-1)*lda+n))
+						x := randomSlice(rnd, vecLen(n, inc))
+						tri := triangular(uplo, diag, n, a, dense(lda))
+						want := refGemv(trans, n, n, 1, tri, max(1, n), x, inc, 0, x, inc)
+
+						Trmv(uplo, trans, diag, n, a, lda, x, inc)
+						name := fmt.Sprintf("uplo=%c,trans=%c,diag=%c,n=%d,inc=%d", uplo, trans, diag, n, inc)
+						checkSlices(t, name, x, want, float64(n))
+					}
+				}
+			}
+		}
+	}
+}
+
+// testTriangularSolve checks a triangular solve by solving op(A)*x = b for
+// a right-hand side b computed from a known solution.
+func testTriangularSolve(t *testing.T, rnd *rand.Rand, name string, uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, n int, a []complex128, idx storage, solve func(x []complex128, incX int)) {
+	t.Helper()
+	makeDominant(uplo, n, a, idx)
+	tri := triangular(uplo, diag, n, a, idx)
+	for _, inc := range incs {
+		want := randomSlice(rnd, vecLen(n, inc))
+		x := refGemv(trans, n, n, 1, tri, max(1, n), want, inc, 0, want, inc)
+		solve(x, inc)
+		checkSlices(t, fmt.Sprintf("%s,inc=%d", name, inc), x, want, float64(n))
+	}
+}
+
+func TestTrsv(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range uplos {
+		for _, trans := range transposes {
+			for _, diag := range diags {
+				for _, n := range level2Sizes {
+					lda := n + 2
+					a := randomSlice(rnd, max(0, (n-1)*lda+n))
+					name := fmt.Sprintf("uplo=%c,trans=%c,diag=%c,n=%d", uplo, trans, diag, n)
+					testTriangularSolve(t, rnd, name, uplo, trans, diag, n, a, dense(lda), func(x []complex128, incX int) {
+						Trsv(uplo, trans, diag, n, a, lda, x, incX)
+					})
+				}
+			}
+		}
+	}
+}
+
+func TestTbsv(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range uplos {
+		for _, trans := range transposes {
+			for _, diag := range diags {
+				for _, n := range level2Sizes {
+					for _, k := range bandwidths {
+						lda := k + 3
+						a := randomSlice(rnd, max(0, (n-1)*lda+k+1))
+						name := fmt.Sprintf("uplo=%c,trans=%c,diag=%c,n=%d,k=%d", uplo, trans, diag, n, k)
+						testTriangularSolve(t, rnd, name, uplo, trans, diag, n, a, band(uplo, k, lda), func(x []complex128, incX int) {
+							Tbsv(uplo, trans, diag, n, k, a, lda, x, incX)
+						})
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestTpsv(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range uplos {
+		for _, trans := range transposes {
+			for _, diag := range diags {
+				for _, n := range level2Sizes {
+					ap := randomSlice(rnd, n*(n+1)/2)
+					name := fmt.Sprintf("uplo=%c,trans=%c,diag=%c,n=%d", uplo, trans, diag, n)
+					testTriangularSolve(t, rnd, name, uplo, trans, diag, n, ap, packed(uplo, n), func(x []complex128, incX int) {
+						Tpsv(uplo, trans, diag, n, ap, x, incX)
+					})
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package c128
+
+import (
+	"fmt"
+	"math/cmplx"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+var (
+	sides = []blas.Side{blas.Left, blas.Right}
+
+	// conjTransposes are the values of trans accepted by Herk and Her2k.
+	conjTransposes = []blas.Transpose{blas.NoTrans, blas.ConjTrans}
+
+	level3Sizes = [][2]int{{0, 0}, {1, 1}, {3, 0}, {3, 5}, {5, 3}, {17, 9}}
+)
+
+// refGemm returns a copy of c in which the m×n matrix C has been replaced by
+// alpha*op(A)*op(B) + beta*C.
+func refGemm(tA, tB blas.Transpose, m, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) []complex128 {
+	want := slices.Clone(c)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			var sum complex128
+			for l := 0; l < k; l++ {
+				sum += op(tA, a, lda, i, l) * op(tB, b, ldb, l, j)
+			}
+			want[i*ldc+j] = alpha*sum + beta*c[i*ldc+j]
+		}
+	}
+	return want
+}
+
+func TestHemm(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, side := range sides {
+		for _, uplo := range uplos {
+			for _, dims := range level3Sizes {
+				for _, beta := range []complex128{0, 1, 0.5 - 2i} {
+					const alpha = -1 + 0.5i
+					m, n := dims[0], dims[1]
+					na := m
+					if side == blas.Right {
+						na = n
+					}
+					lda, ldb, ldc := na+1, n+2, n+3
+					a := randomSlice(rnd, max(0, (na-1)*lda+na))
+					b := randomSlice(rnd, max(0, (m-1)*ldb+n))
+					c := randomSlice(rnd, max(0, (m-1)*ldc+n))
+					h := hermitian(uplo, na, a, dense(lda))
+					var want []complex128
+					if side == blas.Left {
+						want = refGemm(blas.NoTrans, blas.NoTrans, m, n, m, alpha, h, max(1, na), b, ldb, beta, c, ldc)
+					} else {
+						want = refGemm(blas.NoTrans, blas.NoTrans, m, n, n, alpha, b, ldb, h, max(1, na), beta, c, ldc)
+					}
+
+					Hemm(side, uplo, m, n, alpha, a, lda, b, ldb, beta, c, ldc)
+					name := fmt.Sprintf("side=%c,uplo=%c,m=%d,n=%d,beta=%v", side, uplo, m, n, beta)
+					checkSlices(t, name, c, want, float64(max(m, n)))
+				}
+			}
+		}
+	}
+}
+
+// rankUpdate returns a copy of the n×n matrix c in which the uplo triangle of
+// C has been replaced by the corresponding triangle of P + beta*C, with the
+// imaginary parts of the diagonal set to zero. If skip is true, c is returned
+// unchanged, as Herk and Her2k do when alpha or k is zero and beta is one.
+func rankUpdate(uplo blas.Uplo, n int, p []complex128, beta float64, c []complex128, ldc int, skip bool) []complex128 {
+	want := slices.Clone(c)
+	if skip {
+		return want
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if !inTriangle(uplo, i, j) {
+				continue
+			}
+			cij := c[i*ldc+j]
+			if i == j {
+				cij = complex(real(cij), 0)
+			}
+			v := p[i*n+j] + complex(beta, 0)*cij
+			if i == j {
+				v = complex(real(v), 0)
+			}
+			want[i*ldc+j] = v
+		}
+	}
+	return want
+}
+
+// rankOps returns the transposes of the two factors of the product formed by
+// Herk and Her2k for trans.
+func rankOps(trans blas.Transpose) (tA, tB blas.Transpose) {
+	if trans == blas.NoTrans {
+		return blas.NoTrans, blas.ConjTrans
+	}
+	return blas.ConjTrans, blas.NoTrans
+}
+
+func TestHerk(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range uplos {
+		for _, trans := range conjTransposes {
+			for _, dims := range level3Sizes {
+				for _, alpha := range []float64{0, -0.7} {
+					for _, beta := range []float64{0, 1, 0.5} {
+						n, k := dims[0], dims[1]
+						ra, ca := n, k
+						if trans != blas.NoTrans {
+							ra, ca = k, n
+						}
+						lda, ldc := ca+1, n+3
+						a := randomSlice(rnd, max(0, (ra-1)*lda+ca))
+						c := randomSlice(rnd, max(0, (n-1)*ldc+n))
+						tA, tB := rankOps(trans)
+						p := refGemm(tA, tB, n, n, k, complex(alpha, 0), a, lda, a, lda, 0, make([]complex128, n*n), max(1, n))
+						want := rankUpdate(uplo, n, p, beta, c, ldc, (alpha == 0 || k == 0) && beta == 1)
+
+						Herk(uplo, trans, n, k, alpha, a, lda, beta, c, ldc)
+						name := fmt.Sprintf("uplo=%c,trans=%c,n=%d,k=%d,alpha=%v,beta=%v", uplo, trans, n, k, alpha, beta)
+						checkSlices(t, name, c, want, float64(k))
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestHer2k(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range uplos {
+		for _, trans := range conjTransposes {
+			for _, dims := range level3Sizes {
+				for _, alpha := range []complex128{0, 2 - 0.5i} {
+					for _, beta := range []float64{0, 1, 0.5} {
+						n, k := dims[0], dims[1]
+						ra, ca := n, k
+						if trans != blas.NoTrans {
+							ra, ca = k, n
+						}
+						lda, ldb, ldc := ca+1, ca+2, n+3
+						a := randomSlice(rnd, max(0, (ra-1)*lda+ca))
+						b := randomSlice(rnd, max(0, (ra-1)*ldb+ca))
+						c := randomSlice(rnd, max(0, (n-1)*ldc+n))
+						tA, tB := rankOps(trans)
+						p := refGemm(tA, tB, n, n, k, alpha, a, lda, b, ldb, 0, make([]complex128, n*n), max(1, n))
+						p = refGemm(tA, tB, n, n, k, cmplx.Conj(alpha), b, ldb, a, lda, 1, p, max(1, n))
+						want := rankUpdate(uplo, n, p, beta, c, ldc, (alpha == 0 || k == 0) && beta == 1)
+
+						Her2k(uplo, trans, n, k, alpha, a, lda, b, ldb, beta, c, ldc)
+						name := fmt.Sprintf("uplo=%c,trans=%c,n=%d,k=%d,alpha=%v,beta=%v", uplo, trans, n, k, alpha, beta)
+						checkSlices(t, name, c, want, float64(k))
+					}
+				}
+			}
+		}
+	}
+}
+
+// triangularProduct returns a copy of b in which the m×n matrix B has been
+// replaced by alpha*op(T)*B if side is blas.Left, or alpha*B*op(T) otherwise,
+// where T is the triangular matrix with row stride max(1, na).
+func triangularProduct(side blas.Side, trans blas.Transpose, m, n int, alpha complex128, tri []complex128, na int, b []complex128, ldb int) []complex128 {
+	if side == blas.Left {
+		return refGemm(trans, blas.NoTrans, m, n, m, alpha, tri, max(1, na), b, ldb, 0, b, ldb)
+	}
+	return refGemm(blas.NoTrans, trans, m, n, n, alpha, b, ldb, tri, max(1, na), 0, b, ldb)
+}
+
+func TestTrmm(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, side := range sides {
+		for _, uplo := range uplos {
+			for _, trans := range transposes {
+				for _, diag := range diags {
+					for _, dims := range level3Sizes {
+						for _, alpha := range []complex128{0, 1, -1 + 0.5i} {
+							m, n := dims[0], dims[1]
+							na := m
+							if side == blas.Right {
+								na = n
+							}
+							lda, ldb := na+1, n+2
+							a := randomSlice(rnd, max(0, (na-1)*lda+na))
+							b := randomSlice(rnd, max(0, (m-1)*ldb+n))
+							tri := triangular(uplo, diag, na, a, dense(lda))
+							want := triangularProduct(side, trans, m, n, alpha, tri, na, b, ldb)
+
+							Trmm(side, uplo, trans, diag, m, n, alpha, a, lda, b, ldb)
+							name := fmt.Sprintf("side=%c,uplo=%c,trans=%c,diag=%c,m=%d,n=%d,alpha=%v", side, uplo, trans, diag, m, n, alpha)
+							checkSlices(t, name, b, want, float64(na))
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestTrsm(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, side := range sides {
+		for _, uplo := range uplos {
+			for _, trans := range transposes {
+				for _, diag := range diags {
+					for _, dims := range level3Sizes {
+						for _, alpha := range []complex128{0, 1, -1 + 0.5i} {
+							m, n := dims[0], dims[1]
+							na := m
+							if side == blas.Right {
+								na = n
+							}
+							lda, ldb := na+1, n+2
+							a := randomSlice(rnd, max(0, (na-1)*lda+na))
+							makeDominant(uplo, na, a, dense(lda))
+							b := randomSlice(rnd, max(0, (m-1)*ldb+n))
+							// The solution X must satisfy op(A)*X = alpha*B or
+							// X*op(A) = alpha*B, and the padding of B is unchanged.
+							want := slices.Clone(b)
+							for i := 0; i < m; i++ {
+								for j := 0; j < n; j++ {
+									want[i*ldb+j] *= alpha
+								}
+							}
+
+							Trsm(side, uplo, trans, diag, m, n, alpha, a, lda, b, ldb)
+							tri := triangular(uplo, diag, na, a, dense(lda))
+							got := triangularProduct(side, trans, m, n, 1, tri, na, b, ldb)
+							name := fmt.Sprintf("side=%c,uplo=%c,trans=%c,diag=%c,m=%d,n=%d,alpha=%v", side, uplo, trans, diag, m, n, alpha)
+							checkSlices(t, name, got, want, float64(na))
+						}
+					}
+				}
+			}
+		}
+	}
+}
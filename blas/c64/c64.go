@@ -0,0 +1,284 @@
+// Copyright ©2017 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package c64
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+// Implementation is the pure Go complex BLAS implementation provided by this
+// package. It is registered under the name "go" and is the backend used by the
+// package level functions unless another one is selected with Use.
+type Implementation struct{}
+
+var _ blas.Complex64 = Implementation{}
+
+// backend is a registered complex BLAS implementation.
+type backend struct {
+	name string
+	impl blas.Complex64
+}
+
+var (
+	mu       sync.Mutex
+	backends = map[string]*backend{}
+	active   atomic.Pointer[backend]
+)
+
+func init() {
+	Register("go", Implementation{})
+	if err := Use("go"); err != nil {
+		panic(err)
+	}
+}
+
+// Register makes the complex BLAS implementation b available to Use under the
+// given name. Register panics if b is nil or if name is already registered.
+func Register(name string, b blas.Complex64) {
+	if b == nil {
+		panic("c64: nil implementation registered as " + name)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := backends[name]; ok {
+		panic("c64: implementation registered twice as " + name)
+	}
+	backends[name] = &backend{name: name, impl: b}
+}
+
+// Use makes the implementation registered under name the one called by the
+// package level functions. Use returns an error if no implementation has been
+// registered with that name. Calls already in progress complete with the
+// previous implementation.
+func Use(name string) error {
+	mu.Lock()
+	defer mu.Unlock()
+	b, ok := backends[name]
+	if !ok {
+		return fmt.Errorf("c64: no implementation registered as %q", name)
+	}
+	active.Store(b)
+	return nil
+}
+
+// Backend returns the name of the implementation called by the package level
+// functions.
+func Backend() string {
+	return active.Load().name
+}
+
+// Backends returns the sorted names of the registered implementations.
+func Backends() []string {
+	mu.Lock()
+	defer mu.Unlock()
+	names := make([]string, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Dotu computes the dot product of the two vectors without conjugation.
+func Dotu(n int, x []complex64, incX int, y []complex64, incY int) complex64 {
+	return active.Load().impl.Dotu(n, x, incX, y, incY)
+}
+
+// Dotc computes the dot product of the two vectors, conjugating x.
+func Dotc(n int, x []complex64, incX int, y []complex64, incY int) complex64 {
+	return active.Load().impl.Dotc(n, x, incX, y, incY)
+}
+
+// Nrm2 computes the Euclidean norm of a vector.
+func Nrm2(n int, x []complex64, incX int) float32 {
+	return active.Load().impl.Nrm2(n, x, incX)
+}
+
+// Asum computes the sum of |Re(x[i])| + |Im(x[i])| over the elements of x.
+func Asum(n int, x []complex64, incX int) float32 {
+	return active.Load().impl.Asum(n, x, incX)
+}
+
+// Iamax returns the index of an element of x with the largest |Re| + |Im|.
+func Iamax(n int, x []complex64, incX int) int {
+	return active.Load().impl.Iamax(n, x, incX)
+}
+
+// Swap exchanges the elements of two vectors.
+func Swap(n int, x []complex64, incX int, y []complex64, incY int) {
+	active.Load().impl.Swap(n, x, incX, y, incY)
+}
+
+// Copy copies the elements of x into the elements of y.
+func Copy(n int, x []complex64, incX int, y []complex64, incY int) {
+	active.Load().impl.Copy(n, x, incX, y, incY)
+}
+
+// Axpy adds alpha times x to y.
+func Axpy(n int, alpha complex64, x []complex64, incX int, y []complex64, incY int) {
+	active.Load().impl.Axpy(n, alpha, x, incX, y, incY)
+}
+
+// Scal scales x by alpha.
+func Scal(n int, alpha complex64, x []complex64, incX int) {
+	active.Load().impl.Scal(n, alpha, x, incX)
+}
+
+// Sscal scales x by the real scalar alpha.
+func Sscal(n int, alpha float32, x []complex64, incX int) {
+	active.Load().impl.Sscal(n, alpha, x, incX)
+}
+
+// Gemv computes y = alpha * op(A) * x + beta * y for a general m×n matrix A.
+func Gemv(tA blas.Transpose, m, n int, alpha complex64, a []complex64, lda int, x []complex64, incX int, beta complex64, y []complex64, incY int) {
+	active.Load().impl.Gemv(tA, m, n, alpha, a, lda, x, incX, beta, y, incY)
+}
+
+// Gbmv computes y = alpha * op(A) * x + beta * y for a general band matrix A.
+func Gbmv(tA blas.Transpose, m, n, kL, kU int, alpha complex64, a []complex64, lda int, x []complex64, incX int, beta complex64, y []complex64, incY int) {
+	active.Load().impl.Gbmv(tA, m, n, kL, kU, alpha, a, lda, x, incX, beta, y, incY)
+}
+
+// Trmv computes x = op(A) * x for a triangular n×n matrix A.
+func Trmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []complex64, lda int, x []complex64, incX int) {
+	active.Load().impl.Trmv(ul, tA, d, n, a, lda, x, incX)
+}
+
+// Tbmv computes x = op(A) * x for a triangular band matrix A.
+func Tbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []complex64, lda int, x []complex64, incX int) {
+	active.Load().impl.Tbmv(ul, tA, d, n, k, a, lda, x, incX)
+}
+
+// Tpmv computes x = op(A) * x for a triangular matrix A in packed format.
+func Tpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap []complex64, x []complex64, incX int) {
+	active.Load().impl.Tpmv(ul, tA, d, n, ap, x, incX)
+}
+
+// Trsv solves op(A) * x = b for a triangular n×n matrix A, storing x in place of b.
+func Trsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []complex64, lda int, x []complex64, incX int) {
+	active.Load().impl.Trsv(ul, tA, d, n, a, lda, x, incX)
+}
+
+// Tbsv solves op(A) * x = b for a triangular band matrix A, storing x in place of b.
+func Tbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []complex64, lda int, x []complex64, incX int) {
+	active.Load().impl.Tbsv(ul, tA, d, n, k, a, lda, x, incX)
+}
+
+// Tpsv solves op(A) * x = b for a triangular matrix A in packed format, storing x in
+// place of b.
+func Tpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap []complex64, x []complex64, incX int) {
+	active.Load().impl.Tpsv(ul, tA, d, n, ap, x, incX)
+}
+
+// Hemv computes y = alpha * A * x + beta * y for a Hermitian n×n matrix A.
+func Hemv(ul blas.Uplo, n int, alpha complex64, a []complex64, lda int, x []complex64, incX int, beta complex64, y []complex64, incY int) {
+	active.Load().impl.Hemv(ul, n, alpha, a, lda, x, incX, beta, y, incY)
+}
+
+// Hbmv computes y = alpha * A * x + beta * y for a Hermitian band matrix A.
+func Hbmv(ul blas.Uplo, n, k int, alpha complex64, a []complex64, lda int, x []complex64, incX int, beta complex64, y []complex64, incY int) {
+	active.Load().impl.Hbmv(ul, n, k, alpha, a, lda, x, incX, beta, y, incY)
+}
+
+// Hpmv computes y = alpha * A * x + beta * y for a Hermitian matrix A in packed
+// format.
+func Hpmv(ul blas.Uplo, n int, alpha complex64, ap []complex64, x []complex64, incX int, beta complex64, y []complex64, incY int) {
+	active.Load().impl.Hpmv(ul, n, alpha, ap, x, incX, beta, y, incY)
+}
+
+// Geru performs the rank-one update A += alpha * x * yᵀ.
+func Geru(m, n int, alpha complex64, x []complex64, incX int, y []complex64, incY int, a []complex64, lda int) {
+	active.Load().impl.Geru(m, n, alpha, x, incX, y, incY, a, lda)
+}
+
+// Gerc performs the rank-one update A += alpha * x * yᴴ.
+func Gerc(m, n int, alpha complex64, x []complex64, incX int, y []complex64, incY int, a []complex64, lda int) {
+	active.Load().impl.Gerc(m, n, alpha, x, incX, y, incY, a, lda)
+}
+
+// Her performs the Hermitian rank-one update A += alpha * x * xᴴ.
+func Her(ul blas.Uplo, n int, alpha float32, x []complex64, incX int, a []complex64, lda int) {
+	active.Load().impl.Her(ul, n, alpha, x, incX, a, lda)
+}
+
+// Hpr performs the Hermitian rank-one update A += alpha * x * xᴴ for A in packed
+// format.
+func Hpr(ul blas.Uplo, n int, alpha float32, x []complex64, incX int, ap []complex64) {
+	active.Load().impl.Hpr(ul, n, alpha, x, incX, ap)
+}
+
+// Her2 performs the Hermitian rank-two update
+//
+//	A += alpha * x * yᴴ + conj(alpha) * y * xᴴ.
+func Her2(ul blas.Uplo, n int, alpha complex64, x []complex64, incX int, y []complex64, incY int, a []complex64, lda int) {
+	active.Load().impl.Her2(ul, n, alpha, x, incX, y, incY, a, lda)
+}
+
+// Hpr2 performs the Hermitian rank-two update
+//
+//	A += alpha * x * yᴴ + conj(alpha) * y * xᴴ
+//
+// for A in packed format.
+func Hpr2(ul blas.Uplo, n int, alpha complex64, x []complex64, incX int, y []complex64, incY int, ap []complex64) {
+	active.Load().impl.Hpr2(ul, n, alpha, x, incX, y, incY, ap)
+}
+
+// Gemm computes C = alpha * op(A) * op(B) + beta * C.
+func Gemm(tA, tB blas.Transpose, m, n, k int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
+	active.Load().impl.Gemm(tA, tB, m, n, k, alpha, a, lda, b, ldb, beta, c, ldc)
+}
+
+// Symm computes C = alpha * A * B + beta * C or C = alpha * B * A + beta * C
+// for a symmetric matrix A.
+func Symm(s blas.Side, ul blas.Uplo, m, n int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
+	active.Load().impl.Symm(s, ul, m, n, alpha, a, lda, b, ldb, beta, c, ldc)
+}
+
+// Syrk performs the symmetric rank-k update C = alpha * op(A) * op(A)ᵀ + beta * C.
+func Syrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha complex64, a []complex64, lda int, beta complex64, c []complex64, ldc int) {
+	active.Load().impl.Syrk(ul, t, n, k, alpha, a, lda, beta, c, ldc)
+}
+
+// Syr2k performs the symmetric rank-2k update
+//
+//	C = alpha * (op(A) * op(B)ᵀ + op(B) * op(A)ᵀ) + beta * C.
+func Syr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
+	active.Load().impl.Syr2k(ul, t, n, k, alpha, a, lda, b, ldb, beta, c, ldc)
+}
+
+// Trmm computes B = alpha * op(A) * B or B = alpha * B * op(A) for a triangular
+// matrix A.
+func Trmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha complex64, a []complex64, lda int, b []complex64, ldb int) {
+	active.Load().impl.Trmm(s, ul, tA, d, m, n, alpha, a, lda, b, ldb)
+}
+
+// Trsm solves op(A) * X = alpha * B or X * op(A) = alpha * B for a triangular
+// matrix A, storing X in place of B.
+func Trsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha complex64, a []complex64, lda int, b []complex64, ldb int) {
+	active.Load().impl.Trsm(s, ul, tA, d, m, n, alpha, a, lda, b, ldb)
+}
+
+// Hemm computes C = alpha * A * B + beta * C or C = alpha * B * A + beta * C
+// for a Hermitian matrix A.
+func Hemm(s blas.Side, ul blas.Uplo, m, n int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
+	active.Load().impl.Hemm(s, ul, m, n, alpha, a, lda, b, ldb, beta, c, ldc)
+}
+
+// Herk performs the Hermitian rank-k update C = alpha * op(A) * op(A)ᴴ + beta * C.
+func Herk(ul blas.Uplo, t blas.Transpose, n, k int, alpha float32, a []complex64, lda int, beta float32, c []complex64, ldc int) {
+	active.Load().impl.Herk(ul, t, n, k, alpha, a, lda, beta, c, ldc)
+}
+
+// Her2k performs the Hermitian rank-2k update
+//
+//	C = alpha * op(A) * op(B)ᴴ + conj(alpha) * op(B) * op(A)ᴴ + beta * C.
+func Her2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta float32, c []complex64, ldc int) {
+	active.Load().impl.Her2k(ul, t, n, k, alpha, a, lda, b, ldb, beta, c, ldc)
+}
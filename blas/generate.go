@@ -10,59 +10,33 @@ import (
 	"strings"
 )
 
-// Replacement rules for converting blas64 to blas32
-var replacements = []struct {
+// replacement is a rule applied to the source text, either a plain string
+// replacement or a regular expression.
+type replacement struct {
 	pattern string
 	replace string
 	isRegex bool
-}{
+}
+
+// Replacement rules for converting blas64 to blas32
+var replacements = []replacement{
 	// Package name (must be first)
 	{"package blas64", "package blas32", false},
 
 	// Import replacements (before other replacements)
 	{`"github.com/gocnn/gomat/internal/mat/f64"`, `"github.com/gocnn/gomat/internal/mat/f32"`, false},
-	{`"github.com/gocnn/gomat/cblas/cblas64"`, `"github.com/gocnn/gomat/cblas/cblas32"`, false},
 	{`math "github.com/gocnn/gomat/internal/math32"`, `math "github.com/gocnn/gomat/internal/math32"`, false},
 	{`"math"`, `math "github.com/gocnn/gomat/internal/math32"`, false},
 
 	// BLAS parameter types
 	{"blas.DrotmParams", "blas.SrotmParams", false},
 
-	// CBLAS function calls
-	{"cblas64.Axpy", "cblas32.Axpy", false},
-	{"cblas64.Scal", "cblas32.Scal", false},
-	{"cblas64.Copy", "cblas32.Copy", false},
-	{"cblas64.Swap", "cblas32.Swap", false},
-	{"cblas64.Dot", "cblas32.Dot", false},
-	{"cblas64.Nrm2", "cblas32.Nrm2", false},
-	{"cblas64.Asum", "cblas32.Asum", false},
-	{"cblas64.Iamax", "cblas32.Iamax", false},
-	{"cblas64.Rotg", "cblas32.Rotg", false},
-	{"cblas64.Rot", "cblas32.Rot", false},
-	{"cblas64.Rotmg", "cblas32.Rotmg", false},
-	{"cblas64.Rotm", "cblas32.Rotm", false},
-	{"cblas64.Gemv", "cblas32.Gemv", false},
-	{"cblas64.Symv", "cblas32.Symv", false},
-	{"cblas64.Trmv", "cblas32.Trmv", false},
-	{"cblas64.Trsv", "cblas32.Trsv", false},
-	{"cblas64.Ger", "cblas32.Ger", false},
-	{"cblas64.Syr", "cblas32.Syr", false},
-	{"cblas64.Syr2", "cblas32.Syr2", false},
-	{"cblas64.Gbmv", "cblas32.Gbmv", false},
-	{"cblas64.Sbmv", "cblas32.Sbmv", false},
-	{"cblas64.Tbmv", "cblas32.Tbmv", false},
-	{"cblas64.Tbsv", "cblas32.Tbsv", false},
-	{"cblas64.Spmv", "cblas32.Spmv", false},
-	{"cblas64.Tpmv", "cblas32.Tpmv", false},
-	{"cblas64.Tpsv", "cblas32.Tpsv", false},
-	{"cblas64.Spr", "cblas32.Spr", false},
-	{"cblas64.Spr2", "cblas32.Spr2", false},
-	{"cblas64.Gemm", "cblas32.Gemm", false},
-	{"cblas64.Symm", "cblas32.Symm", false},
-	{"cblas64.Trmm", "cblas32.Trmm", false},
-	{"cblas64.Trsm", "cblas32.Trsm", false},
-	{"cblas64.Syrk", "cblas32.Syrk", false},
-	{"cblas64.Syr2k", "cblas32.Syr2k", false},
+	// BLAS interfaces
+	{"blas.Float64", "blas.Float32", false},
+	{"lib.Float64()", "lib.Float32()", false},
+
+	// Panic and error messages
+	{`"blas64: `, `"blas32: `, false},
 
 	// All f64 to f32 internal function calls
 	{"f64.AxpyInc", "f32.AxpyInc", false},
@@ -76,6 +50,11 @@ var replacements = []struct {
 	{"f64.Ger", "f32.Ger", false},
 	{"f64.GemvN", "f32.GemvN", false},
 	{"f64.GemvT", "f32.GemvT", false},
+	{"f64.GemmKernel", "f32.GemmKernel", false},
+	{"f64.GemmMR", "f32.GemmMR", false},
+	{"f64.GemmNR", "f32.GemmNR", false},
+	{"f64.GemmMaxMR", "f32.GemmMaxMR", false},
+	{"f64.GemmMaxNR", "f32.GemmMaxNR", false},
 
 	// Constants
 	{"safmin = 0x1p-1022", "safmin = 0x1p-126", false},
@@ -87,14 +66,53 @@ var replacements = []struct {
 	{"float64", "float32", false},
 }
 
-func main() {
-	// Source and destination directories
-	srcDir := "blas64"
-	dstDir := "blas32"
+// Replacement rules for converting c128 to c64
+var complexReplacements = []replacement{
+	// Package name (must be first)
+	{"package c128", "package c64", false},
+
+	// Import replacements (before other replacements)
+	{`"github.com/gocnn/gomat/internal/mat/c128"`, `"github.com/gocnn/gomat/internal/mat/c64"`, false},
+	{`"math/cmplx"`, `cmplx "github.com/gocnn/gomat/internal/cmplx64"`, false},
+	{`"math"`, `math "github.com/gocnn/gomat/internal/math32"`, false},
+
+	// BLAS interfaces
+	{"blas.Complex128", "blas.Complex64", false},
+
+	// Panic messages
+	{`"c128: `, `"c64: `, false},
 
-	// Files to generate (both pure Go and CBLAS versions)
-	files := []string{"level1.go", "level2.go", "level3.go", "level1_c.go", "level2_c.go", "level3_c.go"}
+	// All c128 to c64 internal function calls
+	{"c128.DscalUnitary", "c64.SscalUnitary", false},
+	{`\bc128\.`, "c64.", true},
+
+	// Scaling by a real number
+	{`\bDscal\b`, "Sscal", true},
+	{"dcabs1", "scabs1", false},
+
+	// Type replacements (must be last to avoid conflicts)
+	{"float64", "float32", false},
+	{"complex128", "complex64", false},
+}
+
+// Packages to generate, each from the files of a double precision package.
+var targets = []struct {
+	srcDir       string
+	dstDir       string
+	files        []string
+	replacements []replacement
+}{
+	{"blas64", "blas32", []string{"blas64.go", "cblas.go", "level1.go", "level2.go", "level3.go"}, replacements},
+	{"c128", "c64", []string{"c128.go", "level1.go", "level2.go", "level3.go"}, complexReplacements},
+}
+
+func main() {
+	for _, t := range targets {
+		generate(t.srcDir, t.dstDir, t.files, t.replacements)
+	}
+}
 
+func generate(srcDir, dstDir string, files []string, replacements []replacement) {
 	// Create destination directory if it doesn't exist
 	if err := os.MkdirAll(dstDir, 0755); err != nil {
 		fmt.Printf("Error creating directory %s: %v\n", dstDir, err)
@@ -103,7 +121,7 @@ func main() {
 
 	for _, file := range files {
 		srcPath := filepath.Join(srcDir, file)
-		dstPath := filepath.Join(dstDir, file)
+		dstPath := filepath.Join(dstDir, strings.Replace(file, srcDir, dstDir, 1))
 
 		// Check if source file exists
 		if _, err := os.Stat(srcPath); os.IsNotExist(err) {
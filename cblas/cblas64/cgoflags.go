@@ -2,8 +2,5 @@ package cblas64
 
 /*
 #cgo CFLAGS: -g -O2
-#cgo windows LDFLAGS: -LC:/PROGRA~2/Intel/oneAPI/mkl/latest/lib -lmkl_rt
-#cgo linux LDFLAGS: -lopenblas
-#cgo darwin LDFLAGS: -framework Accelerate
 */
 import "C"
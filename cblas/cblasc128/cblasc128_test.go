@@ -0,0 +1,137 @@
+//go:build cblas
+
+package cblasc128
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/c128"
+)
+
+// The tests here check the bindings against the pure Go implementation in
+// package c128. They need a CBLAS library to link against, so they are only
+// built with the cblas tag, for example
+//
+//	CGO_LDFLAGS=-lopenblas go test -tags cblas ./cblas/...
+
+func randomSlice(rnd *rand.Rand, n int) []complex128 {
+	s := make([]complex128, n)
+	for i := range s {
+		s[i] = complex(2*rnd.Float64()-1, 2*rnd.Float64()-1)
+	}
+	return s
+}
+
+// near reports whether a and b agree relative to scale.
+func near(a, b complex128, scale float64) bool {
+	return cmplx.Abs(a-b) <= 1e-13*max(scale, 1)
+}
+
+// checkSlices reports an error if got and want differ.
+func checkSlices(t *testing.T, name string, got, want []complex128, scale float64) {
+	t.Helper()
+	for i := range got {
+		if !near(got[i], want[i], scale) {
+			t.Errorf("%s: element %d = %v, want %v", name, i, got[i], want[i])
+			return
+		}
+	}
+}
+
+func TestLevel1(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 3, 7, 32, 101} {
+		for _, inc := range []int{1, 2, -3} {
+			l := 0
+			if n > 0 {
+				l = 1 + (n-1)*max(inc, -inc)
+			}
+			x, y := randomSlice(rnd, l), randomSlice(rnd, l)
+			name := fmt.Sprintf("n=%d,inc=%d", n, inc)
+
+			if got, want := Dotc(n, x, inc, y, inc), c128.Dotc(n, x, inc, y, inc); !near(got, want, float64(n)) {
+				t.Errorf("%s: Dotc = %v, want %v", name, got, want)
+			}
+			if got, want := Dotu(n, x, inc, y, inc), c128.Dotu(n, x, inc, y, inc); !near(got, want, float64(n)) {
+				t.Errorf("%s: Dotu = %v, want %v", name, got, want)
+			}
+			if inc > 0 {
+				got, want := Nrm2(n, x, inc), c128.Nrm2(n, x, inc)
+				if math.Abs(got-want) > 1e-14*max(want, 1) {
+					t.Errorf("%s: Nrm2 = %v, want %v", name, got, want)
+				}
+				got, want = Asum(n, x, inc), c128.Asum(n, x, inc)
+				if math.Abs(got-want) > 1e-14*max(want, 1) {
+					t.Errorf("%s: Asum = %v, want %v", name, got, want)
+				}
+				if got, want := Iamax(n, x, inc), c128.Iamax(n, x, inc); got != want {
+					t.Errorf("%s: Iamax = %v, want %v", name, got, want)
+				}
+			}
+
+			const alpha = 0.5 - 2i
+			yGo := append([]complex128(nil), y...)
+			Axpy(n, alpha, x, inc, y, inc)
+			c128.Axpy(n, alpha, x, inc, yGo, inc)
+			checkSlices(t, name+": Axpy", y, yGo, 1)
+			xGo := append([]complex128(nil), x...)
+			Dscal(n, 3, x, inc)
+			c128.Dscal(n, 3, xGo, inc)
+			checkSlices(t, name+": Dscal", x, xGo, 1)
+		}
+	}
+}
+
+func TestGemv(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{{0, 0}, {1, 1}, {3, 5}, {5, 3}, {17, 33}} {
+		m, n := dims[0], dims[1]
+		for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+			const alpha, beta = 2 - 0.5i, 0.5 + 1i
+			lda := n + 2
+			a := randomSlice(rnd, max(0, (m-1)*lda+n))
+			x := randomSlice(rnd, max(m, n))
+			y := randomSlice(rnd, max(m, n))
+			yGo := append([]complex128(nil), y...)
+			Gemv(trans, m, n, alpha, a, lda, x, 1, beta, y, 1)
+			c128.Gemv(trans, m, n, alpha, a, lda, x, 1, beta, yGo, 1)
+			checkSlices(t, fmt.Sprintf("m=%d,n=%d,trans=%c", m, n, trans), y, yGo, float64(max(m, n)))
+		}
+	}
+}
+
+func TestLevel3(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][3]int{{0, 0, 0}, {1, 1, 1}, {3, 4, 5}, {20, 30, 40}, {65, 33, 70}} {
+		m, n, k := dims[0], dims[1], dims[2]
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.ConjTrans} {
+			for _, tB := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				const alpha, beta = -1 + 0.5i, 0.5 - 2i
+				s := max(m, n, k, 1)
+				a := randomSlice(rnd, s*s)
+				b := randomSlice(rnd, s*s)
+				c := randomSlice(rnd, s*s)
+				cGo := append([]complex128(nil), c...)
+				Gemm(tA, tB, m, n, k, alpha, a, s, b, s, beta, c, s)
+				c128.Gemm(tA, tB, m, n, k, alpha, a, s, b, s, beta, cGo, s)
+				checkSlices(t, fmt.Sprintf("m=%d,n=%d,k=%d,tA=%c,tB=%c: Gemm", m, n, k, tA, tB), c, cGo, float64(k))
+			}
+		}
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, trans := range []blas.Transpose{blas.NoTrans, blas.ConjTrans} {
+				s := max(n, k, 1)
+				a := randomSlice(rnd, s*s)
+				c := randomSlice(rnd, s*s)
+				cGo := append([]complex128(nil), c...)
+				Herk(uplo, trans, n, k, 2, a, s, 0.5, c, s)
+				c128.Herk(uplo, trans, n, k, 2, a, s, 0.5, cGo, s)
+				checkSlices(t, fmt.Sprintf("n=%d,k=%d,uplo=%c,trans=%c: Herk", n, k, uplo, trans), c, cGo, float64(k))
+			}
+		}
+	}
+}
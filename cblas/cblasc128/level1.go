@@ -0,0 +1,357 @@
+package cblasc128
+
+/*
+#include "../cblas.h"
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+// Dotu computes the dot product
+//
+//	xᵀ · y
+//
+// of two complex vectors x and y.
+func Dotu(n int, x []complex128, incX int, y []complex128, incY int) complex128 {
+	// declared at cblas.h:62:8 void cblas_zdotu_sub ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(blas.ErrShortY)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var dotu complex128
+	C.cblas_zdotu_sub(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(&dotu))
+	return dotu
+}
+
+// Dotc computes the dot product
+//
+//	xᴴ · y
+//
+// of two complex vectors x and y.
+func Dotc(n int, x []complex128, incX int, y []complex128, incY int) complex128 {
+	// declared at cblas.h:64:8 void cblas_zdotc_sub ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(blas.ErrShortY)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var dotc complex128
+	C.cblas_zdotc_sub(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(&dotc))
+	return dotc
+}
+
+// Nrm2 computes the Euclidean norm of the complex vector x,
+//
+//	‖x‖_2 = sqrt(\sum_i x[i] * conj(x[i])).
+//
+// This function returns 0 if incX is negative.
+func Nrm2(n int, x []complex128, incX int) float64 {
+	// declared at cblas.h:80:8 double cblas_dznrm2 ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 || incX < 0 {
+		return 0
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(x) <= (n-1)*incX {
+		panic(blas.ErrShortX)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	return float64(C.cblas_dznrm2(C.int(n), unsafe.Pointer(_x), C.int(incX)))
+}
+
+// Asum returns the sum of the absolute values of the elements of x
+//
+//	\sum_i |Re(x[i])| + |Im(x[i])|
+//
+// Asum returns 0 if incX is negative.
+func Asum(n int, x []complex128, incX int) float64 {
+	// declared at cblas.h:81:8 double cblas_dzasum ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 || incX < 0 {
+		return 0
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(x) <= (n-1)*incX {
+		panic(blas.ErrShortX)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	return float64(C.cblas_dzasum(C.int(n), unsafe.Pointer(_x), C.int(incX)))
+}
+
+// Iamax returns the index of the first element of x having largest |Re(·)|+|Im(·)|.
+// Iamax returns -1 if n is 0 or incX is negative.
+func Iamax(n int, x []complex128, incX int) int {
+	// declared at cblas.h:90:13 unsigned long cblas_izamax ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 || incX < 0 {
+		return -1
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(x) <= (n-1)*incX {
+		panic(blas.ErrShortX)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	return int(C.cblas_izamax(C.int(n), unsafe.Pointer(_x), C.int(incX)))
+}
+
+// Swap exchanges the elements of two complex vectors x and y.
+func Swap(n int, x []complex128, incX int, y []complex128, incY int) {
+	// declared at cblas.h:122:6 void cblas_zswap ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(blas.ErrShortY)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_zswap(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY))
+}
+
+// Copy copies the vector x to vector y.
+func Copy(n int, x []complex128, incX int, y []complex128, incY int) {
+	// declared at cblas.h:124:6 void cblas_zcopy ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(blas.ErrShortY)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_zcopy(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY))
+}
+
+// Axpy adds alpha times x to y:
+//
+//	y[i] += alpha * x[i] for all i
+func Axpy(n int, alpha complex128, x []complex128, incX int, y []complex128, incY int) {
+	// declared at cblas.h:126:6 void cblas_zaxpy ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(blas.ErrShortY)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_zaxpy(C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY))
+}
+
+// Scal scales the vector x by a complex scalar alpha.
+// Scal has no effect if incX < 0.
+func Scal(n int, alpha complex128, x []complex128, incX int) {
+	// declared at cblas.h:154:6 void cblas_zscal ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 || incX < 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(x) <= (n-1)*incX {
+		panic(blas.ErrShortX)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_zscal(C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX))
+}
+
+// Dscal scales the vector x by a real scalar alpha.
+// Dscal has no effect if incX < 0.
+func Dscal(n int, alpha float64, x []complex128, incX int) {
+	// declared at cblas.h:156:6 void cblas_zdscal ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 || incX < 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(x) <= (n-1)*incX {
+		panic(blas.ErrShortX)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_zdscal(C.int(n), C.double(alpha), unsafe.Pointer(_x), C.int(incX))
+}
@@ -0,0 +1,1110 @@
+package cblasc128
+
+/*
+#include "../cblas.h"
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+// Gemv performs one of the matrix-vector operations
+//
+//	y = alpha * A * x + beta * y   if tA = blas.NoTrans
+//	y = alpha * Aᵀ * x + beta * y  if tA = blas.Trans
+//	y = alpha * Aᴴ * x + beta * y  if tA = blas.ConjTrans
+//
+// where alpha and beta are scalars, x and y are vectors, and A is an m×n dense matrix.
+func Gemv(tA blas.Transpose, m, n int, alpha complex128, a []complex128, lda int, x []complex128, incX int, beta complex128, y []complex128, incY int) {
+	// declared at cblas.h:266:6 void cblas_zgemv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(blas.ErrBadTranspose)
+	}
+	if m < 0 {
+		panic(blas.ErrMLT0)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if lda < max(1, n) {
+		panic(blas.ErrBadLdA)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(m-1)+n {
+		panic(blas.ErrShortA)
+	}
+	var lenX, lenY int
+	if tA == C.CblasNoTrans {
+		lenX, lenY = n, m
+	} else {
+		lenX, lenY = m, n
+	}
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(blas.ErrShortY)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_zgemv(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_TRANSPOSE(tA), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+}
+
+// Gbmv performs one of the matrix-vector operations
+//
+//	y = alpha * A * x + beta * y   if tA = blas.NoTrans
+//	y = alpha * Aᵀ * x + beta * y  if tA = blas.Trans
+//	y = alpha * Aᴴ * x + beta * y  if tA = blas.ConjTrans
+//
+// where alpha and beta are scalars, x and y are vectors, and A is an m×n band matrix
+// with kL sub-diagonals and kU super-diagonals.
+func Gbmv(tA blas.Transpose, m, n, kL, kU int, alpha complex128, a []complex128, lda int, x []complex128, incX int, beta complex128, y []complex128, incY int) {
+	// declared at cblas.h:271:6 void cblas_zgbmv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(blas.ErrBadTranspose)
+	}
+	if m < 0 {
+		panic(blas.ErrMLT0)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if kL < 0 {
+		panic(blas.ErrKLLT0)
+	}
+	if kU < 0 {
+		panic(blas.ErrKULT0)
+	}
+	if lda < kL+kU+1 {
+		panic(blas.ErrBadLdA)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(min(m, n+kL)-1)+kL+kU+1 {
+		panic(blas.ErrShortA)
+	}
+	var lenX, lenY int
+	if tA == C.CblasNoTrans {
+		lenX, lenY = n, m
+	} else {
+		lenX, lenY = m, n
+	}
+	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
+		panic(blas.ErrShortY)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_zgbmv(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_TRANSPOSE(tA), C.int(m), C.int(n), C.int(kL), C.int(kU), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+}
+
+// Trmv performs one of the matrix-vector operations
+//
+//	x = A * x   if tA = blas.NoTrans
+//	x = Aᵀ * x  if tA = blas.Trans
+//	x = Aᴴ * x  if tA = blas.ConjTrans
+//
+// where x is a vector, and A is an n×n triangular matrix.
+func Trmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []complex128, lda int, x []complex128, incX int) {
+	// declared at cblas.h:276:6 void cblas_ztrmv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(blas.ErrBadTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(blas.ErrBadDiag)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if lda < max(1, n) {
+		panic(blas.ErrBadLdA)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(blas.ErrShortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_ztrmv(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX))
+}
+
+// Tbmv performs one of the matrix-vector operations
+//
+//	x = A * x   if tA = blas.NoTrans
+//	x = Aᵀ * x  if tA = blas.Trans
+//	x = Aᴴ * x  if tA = blas.ConjTrans
+//
+// where x is an n element vector and A is an n×n triangular band matrix, with
+// (k+1) diagonals.
+func Tbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []complex128, lda int, x []complex128, incX int) {
+	// declared at cblas.h:280:6 void cblas_ztbmv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(blas.ErrBadTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(blas.ErrBadDiag)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if k < 0 {
+		panic(blas.ErrKLT0)
+	}
+	if lda < k+1 {
+		panic(blas.ErrBadLdA)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+k+1 {
+		panic(blas.ErrShortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_ztbmv(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), C.int(k), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX))
+}
+
+// Tpmv performs one of the matrix-vector operations
+//
+//	x = A * x   if tA = blas.NoTrans
+//	x = Aᵀ * x  if tA = blas.Trans
+//	x = Aᴴ * x  if tA = blas.ConjTrans
+//
+// where x is an n element vector and A is an n×n triangular matrix, supplied in
+// packed form.
+func Tpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap []complex128, x []complex128, incX int) {
+	// declared at cblas.h:284:6 void cblas_ztpmv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(blas.ErrBadTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(blas.ErrBadDiag)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(ap) < n*(n+1)/2 {
+		panic(blas.ErrShortAP)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_ztpmv(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.int(incX))
+}
+
+// Trsv solves one of the systems of equations
+//
+//	A * x = b   if tA == blas.NoTrans
+//	Aᵀ * x = b  if tA == blas.Trans
+//	Aᴴ * x = b  if tA == blas.ConjTrans
+//
+// where b and x are n element vectors and A is an n×n triangular matrix.
+//
+// On entry, x contains the values of b, and the solution is
+// stored in-place into x.
+//
+// No test for singularity or near-singularity is included in this
+// routine. Such tests must be performed before calling this routine.
+func Trsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []complex128, lda int, x []complex128, incX int) {
+	// declared at cblas.h:287:6 void cblas_ztrsv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(blas.ErrBadTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(blas.ErrBadDiag)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if lda < max(1, n) {
+		panic(blas.ErrBadLdA)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(blas.ErrShortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_ztrsv(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX))
+}
+
+// Tbsv solves one of the systems of equations
+//
+//	A * x = b   if tA == blas.NoTrans
+//	Aᵀ * x = b  if tA == blas.Trans
+//	Aᴴ * x = b  if tA == blas.ConjTrans
+//
+// where b and x are n element vectors and A is an n×n triangular band matrix
+// with (k+1) diagonals.
+//
+// On entry, x contains the values of b, and the solution is
+// stored in-place into x.
+//
+// No test for singularity or near-singularity is included in this
+// routine. Such tests must be performed before calling this routine.
+func Tbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []complex128, lda int, x []complex128, incX int) {
+	// declared at cblas.h:291:6 void cblas_ztbsv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(blas.ErrBadTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(blas.ErrBadDiag)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if k < 0 {
+		panic(blas.ErrKLT0)
+	}
+	if lda < k+1 {
+		panic(blas.ErrBadLdA)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+k+1 {
+		panic(blas.ErrShortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_ztbsv(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), C.int(k), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX))
+}
+
+// Tpsv solves one of the systems of equations
+//
+//	A * x = b   if tA == blas.NoTrans
+//	Aᵀ * x = b  if tA == blas.Trans
+//	Aᴴ * x = b  if tA == blas.ConjTrans
+//
+// where b and x are n element vectors and A is an n×n triangular matrix in
+// packed form.
+//
+// On entry, x contains the values of b, and the solution is
+// stored in-place into x.
+//
+// No test for singularity or near-singularity is included in this
+// routine. Such tests must be performed before calling this routine.
+func Tpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap []complex128, x []complex128, incX int) {
+	// declared at cblas.h:295:6 void cblas_ztpsv ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(blas.ErrBadTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(blas.ErrBadDiag)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(ap) < n*(n+1)/2 {
+		panic(blas.ErrShortAP)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.cblas_ztpsv(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.int(incX))
+}
+
+// Hemv performs the matrix-vector operation
+//
+//	y = alpha * A * x + beta * y
+//
+// where alpha and beta are scalars, x and y are vectors, and A is an n×n
+// Hermitian matrix. The imaginary parts of the diagonal elements of A are
+// ignored and assumed to be zero.
+func Hemv(ul blas.Uplo, n int, alpha complex128, a []complex128, lda int, x []complex128, incX int, beta complex128, y []complex128, incY int) {
+	// declared at cblas.h:396:6 void cblas_zhemv ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if lda < max(1, n) {
+		panic(blas.ErrBadLdA)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+n {
+		panic(blas.ErrShortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(blas.ErrShortY)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_zhemv(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_UPLO(ul), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+}
+
+// Hbmv performs the matrix-vector operation
+//
+//	y = alpha * A * x + beta * y
+//
+// where alpha and beta are scalars, x and y are vectors, and A is an n×n
+// Hermitian band matrix with k super-diagonals. The imaginary parts of
+// the diagonal elements of A are ignored and assumed to be zero.
+func Hbmv(ul blas.Uplo, n, k int, alpha complex128, a []complex128, lda int, x []complex128, incX int, beta complex128, y []complex128, incY int) {
+	// declared at cblas.h:400:6 void cblas_zhbmv ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if k < 0 {
+		panic(blas.ErrKLT0)
+	}
+	if lda < k+1 {
+		panic(blas.ErrBadLdA)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(n-1)+k+1 {
+		panic(blas.ErrShortA)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(blas.ErrShortY)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_zhbmv(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_UPLO(ul), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+}
+
+// Hpmv performs the matrix-vector operation
+//
+//	y = alpha * A * x + beta * y
+//
+// where alpha and beta are scalars, x and y are vectors, and A is an n×n
+// Hermitian matrix in packed form. The imaginary parts of the diagonal
+// elements of A are ignored and assumed to be zero.
+func Hpmv(ul blas.Uplo, n int, alpha complex128, ap []complex128, x []complex128, incX int, beta complex128, y []complex128, incY int) {
+	// declared at cblas.h:404:6 void cblas_zhpmv ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(ap) < n*(n+1)/2 {
+		panic(blas.ErrShortAP)
+	}
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(blas.ErrShortY)
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.cblas_zhpmv(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_UPLO(ul), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+}
+
+// Geru performs the rank-one operation
+//
+//	A += alpha * x * yᵀ
+//
+// where A is an m×n dense matrix, alpha is a scalar, x is an m element vector,
+// and y is an n element vector.
+func Geru(m, n int, alpha complex128, x []complex128, incX int, y []complex128, incY int, a []complex128, lda int) {
+	// declared at cblas.h:408:6 void cblas_zgeru ...
+
+	if m < 0 {
+		panic(blas.ErrMLT0)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if lda < max(1, n) {
+		panic(blas.ErrBadLdA)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (m-1)*incX) || (incX < 0 && len(x) <= (1-m)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(blas.ErrShortY)
+	}
+	if len(a) < lda*(m-1)+n {
+		panic(blas.ErrShortA)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_zgeru(C.CBLAS_LAYOUT(C.CblasRowMajor), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(_a), C.int(lda))
+}
+
+// Gerc performs the rank-one operation
+//
+//	A += alpha * x * yᴴ
+//
+// where A is an m×n dense matrix, alpha is a scalar, x is an m element vector,
+// and y is an n element vector.
+func Gerc(m, n int, alpha complex128, x []complex128, incX int, y []complex128, incY int, a []complex128, lda int) {
+	// declared at cblas.h:411:6 void cblas_zgerc ...
+
+	if m < 0 {
+		panic(blas.ErrMLT0)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if lda < max(1, n) {
+		panic(blas.ErrBadLdA)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (m-1)*incX) || (incX < 0 && len(x) <= (1-m)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(blas.ErrShortY)
+	}
+	if len(a) < lda*(m-1)+n {
+		panic(blas.ErrShortA)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_zgerc(C.CBLAS_LAYOUT(C.CblasRowMajor), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(_a), C.int(lda))
+}
+
+// Her performs the Hermitian rank-one operation
+//
+//	A += alpha * x * xᴴ
+//
+// where A is an n×n Hermitian matrix, alpha is a real scalar, and x is an n
+// element vector. On entry, the imaginary parts of the diagonal elements of A
+// are ignored and assumed to be zero, on return they will be set to zero.
+func Her(ul blas.Uplo, n int, alpha float64, x []complex128, incX int, a []complex128, lda int) {
+	// declared at cblas.h:414:6 void cblas_zher ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if lda < max(1, n) {
+		panic(blas.ErrBadLdA)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if len(a) < lda*(n-1)+n {
+		panic(blas.ErrShortA)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_zher(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_UPLO(ul), C.int(n), C.double(alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_a), C.int(lda))
+}
+
+// Hpr performs the Hermitian rank-1 operation
+//
+//	A += alpha * x * xᴴ
+//
+// where alpha is a real scalar, x is a vector, and A is an n×n hermitian matrix
+// in packed form. On entry, the imaginary parts of the diagonal elements are
+// assumed to be zero, and on return they are set to zero.
+func Hpr(ul blas.Uplo, n int, alpha float64, x []complex128, incX int, ap []complex128) {
+	// declared at cblas.h:417:6 void cblas_zhpr ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if len(ap) < n*(n+1)/2 {
+		panic(blas.ErrShortAP)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _ap *complex128
+	if len(ap) > 0 {
+		_ap = &ap[0]
+	}
+	C.cblas_zhpr(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_UPLO(ul), C.int(n), C.double(alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_ap))
+}
+
+// Her2 performs the Hermitian rank-two operation
+//
+//	A += alpha * x * yᴴ + conj(alpha) * y * xᴴ
+//
+// where alpha is a scalar, x and y are n element vectors and A is an n×n
+// Hermitian matrix. On entry, the imaginary parts of the diagonal elements are
+// ignored and assumed to be zero. On return they will be set to zero.
+func Her2(ul blas.Uplo, n int, alpha complex128, x []complex128, incX int, y []complex128, incY int, a []complex128, lda int) {
+	// declared at cblas.h:420:6 void cblas_zher2 ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if lda < max(1, n) {
+		panic(blas.ErrBadLdA)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(blas.ErrShortY)
+	}
+	if len(a) < lda*(n-1)+n {
+		panic(blas.ErrShortA)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	C.cblas_zher2(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_UPLO(ul), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(_a), C.int(lda))
+}
+
+// Hpr2 performs the Hermitian rank-2 operation
+//
+//	A += alpha * x * yᴴ + conj(alpha) * y * xᴴ
+//
+// where alpha is a complex scalar, x and y are n element vectors, and A is an
+// n×n Hermitian matrix, supplied in packed form. On entry, the imaginary parts
+// of the diagonal elements are assumed to be zero, and on return they are set to zero.
+func Hpr2(ul blas.Uplo, n int, alpha complex128, x []complex128, incX int, y []complex128, incY int, ap []complex128) {
+	// declared at cblas.h:423:6 void cblas_zhpr2 ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(blas.ErrShortY)
+	}
+	if len(ap) < n*(n+1)/2 {
+		panic(blas.ErrShortAP)
+	}
+	var _x *complex128
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *complex128
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	var _a *complex128
+	if len(ap) > 0 {
+		_a = &ap[0]
+	}
+	C.cblas_zhpr2(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_UPLO(ul), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(_a))
+}
@@ -0,0 +1,742 @@
+package cblasc128
+
+/*
+#include "../cblas.h"
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+// Gemm performs one of the matrix-matrix operations
+//
+//	C = alpha * op(A) * op(B) + beta * C
+//
+// where op(X) is one of
+//
+//	op(X) = X  or  op(X) = Xᵀ  or  op(X) = Xᴴ,
+//
+// alpha and beta are scalars, and A, B and C are matrices, with op(A) an m×k matrix,
+// op(B) a k×n matrix and C an m×n matrix.
+func Gemm(tA, tB blas.Transpose, m, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
+	// declared at cblas.h:526:6 void cblas_zgemm ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(blas.ErrBadTranspose)
+	}
+	switch tB {
+	case blas.NoTrans:
+		tB = C.CblasNoTrans
+	case blas.Trans:
+		tB = C.CblasTrans
+	case blas.ConjTrans:
+		tB = C.CblasConjTrans
+	default:
+		panic(blas.ErrBadTranspose)
+	}
+	if m < 0 {
+		panic(blas.ErrMLT0)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if k < 0 {
+		panic(blas.ErrKLT0)
+	}
+	var rowA, colA, rowB, colB int
+	if tA == C.CblasNoTrans {
+		rowA, colA = m, k
+	} else {
+		rowA, colA = k, m
+	}
+	if tB == C.CblasNoTrans {
+		rowB, colB = k, n
+	} else {
+		rowB, colB = n, k
+	}
+	if lda < max(1, colA) {
+		panic(blas.ErrBadLdA)
+	}
+	if ldb < max(1, colB) {
+		panic(blas.ErrBadLdB)
+	}
+	if ldc < max(1, n) {
+		panic(blas.ErrBadLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(rowA-1)+colA {
+		panic(blas.ErrShortA)
+	}
+	if len(b) < ldb*(rowB-1)+colB {
+		panic(blas.ErrShortB)
+	}
+	if len(c) < ldc*(m-1)+n {
+		panic(blas.ErrShortC)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_zgemm(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_TRANSPOSE(tA), C.CBLAS_TRANSPOSE(tB), C.int(m), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+}
+
+// Symm performs one of the matrix-matrix operations
+//
+//	C = alpha*A*B + beta*C  if s == blas.Left
+//	C = alpha*B*A + beta*C  if s == blas.Right
+//
+// where alpha and beta are scalars, A is an m×m or n×n symmetric matrix and B
+// and C are m×n matrices.
+func Symm(s blas.Side, ul blas.Uplo, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
+	// declared at cblas.h:531:6 void cblas_zsymm ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	switch s {
+	case blas.Left:
+		s = C.CblasLeft
+	case blas.Right:
+		s = C.CblasRight
+	default:
+		panic(blas.ErrBadSide)
+	}
+	if m < 0 {
+		panic(blas.ErrMLT0)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	var k int
+	if s == C.CblasLeft {
+		k = m
+	} else {
+		k = n
+	}
+	if lda < max(1, k) {
+		panic(blas.ErrBadLdA)
+	}
+	if ldb < max(1, n) {
+		panic(blas.ErrBadLdB)
+	}
+	if ldc < max(1, n) {
+		panic(blas.ErrBadLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(k-1)+k {
+		panic(blas.ErrShortA)
+	}
+	if len(b) < ldb*(m-1)+n {
+		panic(blas.ErrShortB)
+	}
+	if len(c) < ldc*(m-1)+n {
+		panic(blas.ErrShortC)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_zsymm(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+}
+
+// Syrk performs one of the symmetric rank-k operations
+//
+//	C = alpha*A*Aᵀ + beta*C  if t == blas.NoTrans
+//	C = alpha*Aᵀ*A + beta*C  if t == blas.Trans
+//
+// where alpha and beta are scalars, C is an n×n symmetric matrix and A is
+// an n×k matrix in the first case and a k×n matrix in the second case.
+func Syrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, beta complex128, c []complex128, ldc int) {
+	// declared at cblas.h:536:6 void cblas_zsyrk ...
+
+	switch t {
+	case blas.NoTrans:
+		t = C.CblasNoTrans
+	case blas.Trans:
+		t = C.CblasTrans
+	default:
+		panic(blas.ErrBadTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if k < 0 {
+		panic(blas.ErrKLT0)
+	}
+	var row, col int
+	if t == C.CblasNoTrans {
+		row, col = n, k
+	} else {
+		row, col = k, n
+	}
+	if lda < max(1, col) {
+		panic(blas.ErrBadLdA)
+	}
+	if ldc < max(1, n) {
+		panic(blas.ErrBadLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(row-1)+col {
+		panic(blas.ErrShortA)
+	}
+	if len(c) < ldc*(n-1)+n {
+		panic(blas.ErrShortC)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_zsyrk(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+}
+
+// Syr2k performs one of the symmetric rank-2k operations
+//
+//	C = alpha*A*Bᵀ + alpha*B*Aᵀ + beta*C  if t == blas.NoTrans
+//	C = alpha*Aᵀ*B + alpha*Bᵀ*A + beta*C  if t == blas.Trans
+//
+// where alpha and beta are scalars, C is an n×n symmetric matrix and A and B
+// are n×k matrices in the first case and k×n matrices in the second case.
+func Syr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
+	// declared at cblas.h:540:6 void cblas_zsyr2k ...
+
+	switch t {
+	case blas.NoTrans:
+		t = C.CblasNoTrans
+	case blas.Trans:
+		t = C.CblasTrans
+	default:
+		panic(blas.ErrBadTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if k < 0 {
+		panic(blas.ErrKLT0)
+	}
+	var row, col int
+	if t == C.CblasNoTrans {
+		row, col = n, k
+	} else {
+		row, col = k, n
+	}
+	if lda < max(1, col) {
+		panic(blas.ErrBadLdA)
+	}
+	if ldb < max(1, col) {
+		panic(blas.ErrBadLdB)
+	}
+	if ldc < max(1, n) {
+		panic(blas.ErrBadLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(row-1)+col {
+		panic(blas.ErrShortA)
+	}
+	if len(b) < ldb*(row-1)+col {
+		panic(blas.ErrShortB)
+	}
+	if len(c) < ldc*(n-1)+n {
+		panic(blas.ErrShortC)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_zsyr2k(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+}
+
+// Trmm performs one of the matrix-matrix operations
+//
+//	B = alpha * op(A) * B  if s == blas.Left,
+//	B = alpha * B * op(A)  if s == blas.Right,
+//
+// where alpha is a scalar, B is an m×n matrix, A is a unit, or non-unit,
+// upper or lower triangular matrix and op(A) is one of
+//
+//	op(A) = A   if tA == blas.NoTrans,
+//	op(A) = Aᵀ  if tA == blas.Trans,
+//	op(A) = Aᴴ  if tA == blas.ConjTrans.
+func Trmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int) {
+	// declared at cblas.h:545:6 void cblas_ztrmm ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(blas.ErrBadTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(blas.ErrBadDiag)
+	}
+	switch s {
+	case blas.Left:
+		s = C.CblasLeft
+	case blas.Right:
+		s = C.CblasRight
+	default:
+		panic(blas.ErrBadSide)
+	}
+	if m < 0 {
+		panic(blas.ErrMLT0)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	var k int
+	if s == C.CblasLeft {
+		k = m
+	} else {
+		k = n
+	}
+	if lda < max(1, k) {
+		panic(blas.ErrBadLdA)
+	}
+	if ldb < max(1, n) {
+		panic(blas.ErrBadLdB)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(k-1)+k {
+		panic(blas.ErrShortA)
+	}
+	if len(b) < ldb*(m-1)+n {
+		panic(blas.ErrShortB)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	C.cblas_ztrmm(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb))
+}
+
+// Trsm solves one of the matrix equations
+//
+//	op(A) * X = alpha * B  if s == blas.Left,
+//	X * op(A) = alpha * B  if s == blas.Right,
+//
+// where alpha is a scalar, X and B are m×n matrices, A is a unit or
+// non-unit, upper or lower triangular matrix and op(A) is one of
+//
+//	op(A) = A   if tA == blas.NoTrans,
+//	op(A) = Aᵀ  if tA == blas.Trans,
+//	op(A) = Aᴴ  if tA == blas.ConjTrans.
+//
+// On return the matrix X is overwritten on B.
+func Trsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int) {
+	// declared at cblas.h:550:6 void cblas_ztrsm ...
+
+	switch tA {
+	case blas.NoTrans:
+		tA = C.CblasNoTrans
+	case blas.Trans:
+		tA = C.CblasTrans
+	case blas.ConjTrans:
+		tA = C.CblasConjTrans
+	default:
+		panic(blas.ErrBadTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	switch d {
+	case blas.NonUnit:
+		d = C.CblasNonUnit
+	case blas.Unit:
+		d = C.CblasUnit
+	default:
+		panic(blas.ErrBadDiag)
+	}
+	switch s {
+	case blas.Left:
+		s = C.CblasLeft
+	case blas.Right:
+		s = C.CblasRight
+	default:
+		panic(blas.ErrBadSide)
+	}
+	if m < 0 {
+		panic(blas.ErrMLT0)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	var k int
+	if s == C.CblasLeft {
+		k = m
+	} else {
+		k = n
+	}
+	if lda < max(1, k) {
+		panic(blas.ErrBadLdA)
+	}
+	if ldb < max(1, n) {
+		panic(blas.ErrBadLdB)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(k-1)+k {
+		panic(blas.ErrShortA)
+	}
+	if len(b) < ldb*(m-1)+n {
+		panic(blas.ErrShortB)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	C.cblas_ztrsm(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(tA), C.CBLAS_DIAG(d), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb))
+}
+
+// Hemm performs one of the matrix-matrix operations
+//
+//	C = alpha*A*B + beta*C  if s == blas.Left
+//	C = alpha*B*A + beta*C  if s == blas.Right
+//
+// where alpha and beta are scalars, A is an m×m or n×n hermitian matrix and B
+// and C are m×n matrices. The imaginary parts of the diagonal elements of A are
+// assumed to be zero.
+func Hemm(s blas.Side, ul blas.Uplo, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
+	// declared at cblas.h:575:6 void cblas_zhemm ...
+
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	switch s {
+	case blas.Left:
+		s = C.CblasLeft
+	case blas.Right:
+		s = C.CblasRight
+	default:
+		panic(blas.ErrBadSide)
+	}
+	if m < 0 {
+		panic(blas.ErrMLT0)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	var k int
+	if s == C.CblasLeft {
+		k = m
+	} else {
+		k = n
+	}
+	if lda < max(1, k) {
+		panic(blas.ErrBadLdA)
+	}
+	if ldb < max(1, n) {
+		panic(blas.ErrBadLdB)
+	}
+	if ldc < max(1, n) {
+		panic(blas.ErrBadLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(k-1)+k {
+		panic(blas.ErrShortA)
+	}
+	if len(b) < ldb*(m-1)+n {
+		panic(blas.ErrShortB)
+	}
+	if len(c) < ldc*(m-1)+n {
+		panic(blas.ErrShortC)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_zhemm(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_SIDE(s), C.CBLAS_UPLO(ul), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+}
+
+// Herk performs one of the hermitian rank-k operations
+//
+//	C = alpha*A*Aᴴ + beta*C  if t == blas.NoTrans
+//	C = alpha*Aᴴ*A + beta*C  if t == blas.ConjTrans
+//
+// where alpha and beta are real scalars, C is an n×n hermitian matrix and A is
+// an n×k matrix in the first case and a k×n matrix in the second case.
+//
+// The imaginary parts of the diagonal elements of C are assumed to be zero, and
+// on return they will be set to zero.
+func Herk(ul blas.Uplo, t blas.Transpose, n, k int, alpha float64, a []complex128, lda int, beta float64, c []complex128, ldc int) {
+	// declared at cblas.h:580:6 void cblas_zherk ...
+
+	switch t {
+	case blas.NoTrans:
+		t = C.CblasNoTrans
+	case blas.ConjTrans:
+		t = C.CblasConjTrans
+	default:
+		panic(blas.ErrBadTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if k < 0 {
+		panic(blas.ErrKLT0)
+	}
+	var row, col int
+	if t == C.CblasNoTrans {
+		row, col = n, k
+	} else {
+		row, col = k, n
+	}
+	if lda < max(1, col) {
+		panic(blas.ErrBadLdA)
+	}
+	if ldc < max(1, n) {
+		panic(blas.ErrBadLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(row-1)+col {
+		panic(blas.ErrShortA)
+	}
+	if len(c) < ldc*(n-1)+n {
+		panic(blas.ErrShortC)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_zherk(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.int(n), C.int(k), C.double(alpha), unsafe.Pointer(_a), C.int(lda), C.double(beta), unsafe.Pointer(_c), C.int(ldc))
+}
+
+// Her2k performs one of the hermitian rank-2k operations
+//
+//	C = alpha*A*Bᴴ + conj(alpha)*B*Aᴴ + beta*C  if t == blas.NoTrans
+//	C = alpha*Aᴴ*B + conj(alpha)*Bᴴ*A + beta*C  if t == blas.ConjTrans
+//
+// where alpha and beta are scalars with beta real, C is an n×n hermitian matrix
+// and A and B are n×k matrices in the first case and k×n matrices in the second case.
+//
+// The imaginary parts of the diagonal elements of C are assumed to be zero, and
+// on return they will be set to zero.
+func Her2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta float64, c []complex128, ldc int) {
+	// declared at cblas.h:584:6 void cblas_zher2k ...
+
+	switch t {
+	case blas.NoTrans:
+		t = C.CblasNoTrans
+	case blas.ConjTrans:
+		t = C.CblasConjTrans
+	default:
+		panic(blas.ErrBadTranspose)
+	}
+	switch ul {
+	case blas.Upper:
+		ul = C.CblasUpper
+	case blas.Lower:
+		ul = C.CblasLower
+	default:
+		panic(blas.ErrBadUplo)
+	}
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if k < 0 {
+		panic(blas.ErrKLT0)
+	}
+	var row, col int
+	if t == C.CblasNoTrans {
+		row, col = n, k
+	} else {
+		row, col = k, n
+	}
+	if lda < max(1, col) {
+		panic(blas.ErrBadLdA)
+	}
+	if ldb < max(1, col) {
+		panic(blas.ErrBadLdB)
+	}
+	if ldc < max(1, n) {
+		panic(blas.ErrBadLdC)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(a) < lda*(row-1)+col {
+		panic(blas.ErrShortA)
+	}
+	if len(b) < ldb*(row-1)+col {
+		panic(blas.ErrShortB)
+	}
+	if len(c) < ldc*(n-1)+n {
+		panic(blas.ErrShortC)
+	}
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _c *complex128
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	C.cblas_zher2k(C.CBLAS_LAYOUT(C.CblasRowMajor), C.CBLAS_UPLO(ul), C.CBLAS_TRANSPOSE(t), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), C.double(beta), unsafe.Pointer(_c), C.int(ldc))
+}
@@ -0,0 +1,6 @@
+package cblasc64
+
+/*
+#cgo CFLAGS: -g -O2
+*/
+import "C"
@@ -0,0 +1,133 @@
+//go:build cgo && unix
+
+package cblasdl
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// stubSource is a CBLAS stand-in where only ddot, sdot and dscal compute
+// anything. The remaining symbols are added by buildStub.
+const stubSource = `
+double cblas_ddot(const int n, const double *x, const int incx, const double *y, const int incy) {
+	double sum = 0;
+	for (int i = 0; i < n; i++) sum += x[i*incx] * y[i*incy];
+	return sum + 1000;
+}
+
+float cblas_sdot(const int n, const float *x, const int incx, const float *y, const int incy) {
+	float sum = 0;
+	for (int i = 0; i < n; i++) sum += x[i*incx] * y[i*incy];
+	return sum;
+}
+
+void cblas_dscal(const int n, const double alpha, double *x, const int incx) {
+	for (int i = 0; i < n; i++) x[i*incx] *= alpha;
+}
+`
+
+// buildStub compiles a shared library defining all symbols used by the
+// package except those in omit.
+func buildStub(t *testing.T, name string, omit ...string) string {
+	t.Helper()
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		t.Skip("no C compiler available")
+	}
+	var src strings.Builder
+	src.WriteString(stubSource)
+	for _, sym := range symbolNames {
+		switch sym {
+		case "cblas_ddot", "cblas_sdot", "cblas_dscal":
+			continue
+		}
+		if slices.Contains(omit, sym) {
+			continue
+		}
+		fmt.Fprintf(&src, "void %s(void) {}\n", sym)
+	}
+	dir := t.TempDir()
+	cfile := filepath.Join(dir, name+".c")
+	if err := os.WriteFile(cfile, []byte(src.String()), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	lib := filepath.Join(dir, name+".so")
+	out, err := exec.Command(cc, "-shared", "-fPIC", "-o", lib, cfile).CombinedOutput()
+	if err != nil {
+		t.Fatalf("building stub library: %v\n%s", err, out)
+	}
+	return lib
+}
+
+func TestLoad(t *testing.T) {
+	path := buildStub(t, "libstub")
+	lib, err := Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error loading stub: %v", err)
+	}
+	if lib.Path() != path {
+		t.Errorf("unexpected path: got %q, want %q", lib.Path(), path)
+	}
+
+	x := []float64{1, 2, 3}
+	y := []float64{4, 5, 6}
+	if got, want := lib.Float64().Dot(3, x, 1, y, 1), 1032.0; got != want {
+		t.Errorf("unexpected Dot result: got %v, want %v", got, want)
+	}
+	if got, want := lib.Float32().Dot(2, []float32{1, 2}, 1, []float32{3, 4}, 1), float32(11); got != want {
+		t.Errorf("unexpected float32 Dot result: got %v, want %v", got, want)
+	}
+	lib.Float64().Scal(2, 3, x, 2)
+	if x[0] != 3 || x[1] != 2 || x[2] != 9 {
+		t.Errorf("unexpected Scal result: %v", x)
+	}
+
+	// Arguments are checked before calling into the library.
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected panic for short x")
+			}
+		}()
+		lib.Float64().Dot(4, x, 1, y, 1)
+	}()
+}
+
+func TestLoadEnv(t *testing.T) {
+	path := buildStub(t, "libenv")
+	t.Setenv(EnvLibrary, strings.Join([]string{"libdoesnotexist.so", path}, string(os.PathListSeparator)))
+	lib, err := Load()
+	if err != nil {
+		t.Fatalf("unexpected error loading from %s: %v", EnvLibrary, err)
+	}
+	if lib.Path() != path {
+		t.Errorf("unexpected path: got %q, want %q", lib.Path(), path)
+	}
+}
+
+func TestLoadMissingSymbol(t *testing.T) {
+	path := buildStub(t, "libpartial", "cblas_dgemm")
+	_, err := Load(path)
+	if err == nil {
+		t.Fatal("expected error for library without cblas_dgemm")
+	}
+	if !strings.Contains(err.Error(), "missing cblas_dgemm") {
+		t.Errorf("error does not name the missing symbol: %v", err)
+	}
+}
+
+func TestLoadNotFound(t *testing.T) {
+	_, err := Load(filepath.Join(t.TempDir(), "libnothere.so"))
+	if err == nil {
+		t.Fatal("expected error for missing library")
+	}
+	if !strings.Contains(err.Error(), "libnothere.so") {
+		t.Errorf("error does not name the library: %v", err)
+	}
+}
@@ -0,0 +1,170 @@
+//go:build cgo && unix
+
+package cblasdl
+
+/*
+#cgo linux LDFLAGS: -ldl
+#include <dlfcn.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"unsafe"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+// EnvLibrary is the environment variable holding a list of shared libraries,
+// separated by os.PathListSeparator, that Load tries before the defaults.
+const EnvLibrary = "GOMAT_CBLAS"
+
+// Library is a CBLAS shared library loaded at run time. A Library is never
+// unloaded.
+type Library struct {
+	path string
+	syms [numSymbols]unsafe.Pointer
+}
+
+// Float64 is the double precision BLAS implementation of a Library.
+type Float64 struct {
+	lib *Library
+}
+
+// Float32 is the single precision BLAS implementation of a Library.
+type Float32 struct {
+	lib *Library
+}
+
+var (
+	_ blas.Float64 = Float64{}
+	_ blas.Float32 = Float32{}
+)
+
+// Load loads the first usable CBLAS library from names. If names is empty,
+// the libraries listed in the EnvLibrary environment variable are tried
+// followed by the usual names of OpenBLAS, MKL and reference BLAS for the
+// current platform. Names without a path separator are searched for by the
+// dynamic linker.
+//
+// A library is usable if it provides all the cblas_* functions called by this
+// package. If no library is usable, the returned error describes why each
+// candidate was rejected.
+func Load(names ...string) (*Library, error) {
+	if len(names) == 0 {
+		names = defaultNames()
+	}
+	var errs []error
+	for _, name := range names {
+		lib, err := open(name)
+		if err == nil {
+			return lib, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, fmt.Errorf("cblasdl: no usable CBLAS library: %w", errors.Join(errs...))
+}
+
+// Path returns the name the library was loaded with.
+func (l *Library) Path() string {
+	return l.path
+}
+
+// Float64 returns the double precision BLAS implementation of the library.
+func (l *Library) Float64() Float64 {
+	return Float64{lib: l}
+}
+
+// Float32 returns the single precision BLAS implementation of the library.
+func (l *Library) Float32() Float32 {
+	return Float32{lib: l}
+}
+
+// defaultNames returns the libraries listed in EnvLibrary followed by the
+// platform defaults.
+func defaultNames() []string {
+	var names []string
+	if env := os.Getenv(EnvLibrary); env != "" {
+		for _, name := range filepath.SplitList(env) {
+			if name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	switch runtime.GOOS {
+	case "darwin":
+		names = append(names,
+			"libopenblas.dylib",
+			"libmkl_rt.dylib",
+			"/System/Library/Frameworks/Accelerate.framework/Accelerate",
+		)
+	default:
+		names = append(names,
+			"libopenblas.so.0",
+			"libopenblas.so",
+			"libmkl_rt.so.2",
+			"libmkl_rt.so",
+			"libcblas.so.3",
+			"libblas.so.3",
+			"libblas.so",
+		)
+	}
+	return names
+}
+
+// open loads the named library and resolves all symbols.
+func open(name string) (*Library, error) {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+
+	h := C.dlopen(cname, C.RTLD_NOW|C.RTLD_LOCAL)
+	if h == nil {
+		return nil, fmt.Errorf("%s: %s", name, dlerror())
+	}
+	lib := &Library{path: name}
+	var missing []string
+	for i, sym := range symbolNames {
+		p, err := dlsym(h, sym)
+		if err != nil {
+			missing = append(missing, sym)
+			continue
+		}
+		lib.syms[i] = p
+	}
+	if len(missing) != 0 {
+		C.dlclose(h)
+		if len(missing) > 3 {
+			missing = append(missing[:3], fmt.Sprintf("and %d more", len(missing)-3))
+		}
+		return nil, fmt.Errorf("%s: missing %s", name, strings.Join(missing, ", "))
+	}
+	return lib, nil
+}
+
+// dlsym returns the address of the named symbol in the library h.
+func dlsym(h unsafe.Pointer, name string) (unsafe.Pointer, error) {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+
+	C.dlerror()
+	p := C.dlsym(h, cname)
+	if p == nil {
+		return nil, errors.New(dlerror())
+	}
+	return p, nil
+}
+
+// dlerror returns the last dynamic linking error.
+func dlerror() string {
+	msg := C.dlerror()
+	if msg == nil {
+		return "unknown dynamic linking error"
+	}
+	return C.GoString(msg)
+}
@@ -0,0 +1,30 @@
+// Package cblasdl provides the BLAS routines of a CBLAS shared library that
+// is located and loaded at run time, so that binaries do not need to be
+// linked against OpenBLAS, MKL or a reference BLAS to use one when present.
+//
+// Load searches for a library, resolves every cblas_* function used by the
+// package and returns a clean error if none is usable. blas64 and blas32
+// register the library found by Load under the name "cblas", and load it when
+// that backend is selected with Use. A specific library can be registered
+// under another name:
+//
+//	lib, err := cblasdl.Load("/opt/OpenBLAS/lib/libopenblas.so")
+//	if err == nil {
+//		blas64.Register("openblas", lib.Float64())
+//		blas32.Register("openblas", lib.Float32())
+//		blas64.Use("openblas")
+//	}
+//
+// If Load fails, the pure Go implementation stays in use.
+//
+// Only the CBLAS interface is loaded. LAPACKE is not: the LAPACK routines in
+// lapack64 and lapack32 are implemented in Go and use the selected BLAS
+// backend.
+//
+// The package requires cgo and a Unix dlopen. It is empty on other systems,
+// including Windows, where blas64 and blas32 have no "cblas" backend. There,
+// a CBLAS library such as MKL can only be used by linking it into the cgo
+// bindings in cblas64, cblas32, cblasc128 and cblasc64 with CGO_LDFLAGS.
+package cblasdl
+
+//go:generate go run generate.go
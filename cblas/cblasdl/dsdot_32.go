@@ -0,0 +1,99 @@
+//go:build cgo && unix
+
+package cblasdl
+
+/*
+#include "../cblas.h"
+
+static float dl_cblas_sdsdot(void *fn, const CBLAS_INT N, const float alpha, const float *X, const CBLAS_INT incX, const float *Y, const CBLAS_INT incY) {
+	return ((__typeof__(&cblas_sdsdot))fn)(N, alpha, X, incX, Y, incY);
+}
+static double dl_cblas_dsdot(void *fn, const CBLAS_INT N, const float *X, const CBLAS_INT incX, const float *Y, const CBLAS_INT incY) {
+	return ((__typeof__(&cblas_dsdot))fn)(N, X, incX, Y, incY);
+}
+*/
+import "C"
+
+import "github.com/gocnn/gomat/blas"
+
+// Sdsdot computes the dot product of the two vectors plus a constant
+//
+//	alpha + \sum_i x[i]*y[i]
+//
+// The dot product is accumulated in double precision.
+func (impl Float32) Sdsdot(n int, alpha float32, x []float32, incX int, y []float32, incY int) float32 {
+	// declared at cblas.h:45:8 float cblas_sdsdot ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return alpha
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(blas.ErrShortY)
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float32
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	return float32(C.dl_cblas_sdsdot(impl.lib.syms[symSdsdot], C.int(n), C.float(alpha), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY)))
+}
+
+// Dsdot computes the dot product of the two vectors
+//
+//	\sum_i x[i]*y[i]
+//
+// The dot product is accumulated and returned in double precision.
+func (impl Float32) Dsdot(n int, x []float32, incX int, y []float32, incY int) float64 {
+	// declared at cblas.h:47:8 double cblas_dsdot ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(blas.ErrShortY)
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float32
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	return float64(C.dl_cblas_dsdot(impl.lib.syms[symDsdot], C.int(n), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY)))
+}
@@ -0,0 +1,153 @@
+//go:build ignore
+
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Sources of the Go side argument checks. Each exported function becomes a
+// method of the named receiver type, calling through a resolved symbol
+// instead of a link time reference.
+var sources = []struct {
+	src, dst, recv string
+}{
+	{"../cblas64/level1.go", "level1_64.go", "Float64"},
+	{"../cblas64/level2.go", "level2_64.go", "Float64"},
+	{"../cblas64/level3.go", "level3_64.go", "Float64"},
+	{"../cblas32/level1.go", "level1_32.go", "Float32"},
+	{"../cblas32/level2.go", "level2_32.go", "Float32"},
+	{"../cblas32/level3.go", "level3_32.go", "Float32"},
+	{"../cblas32/dsdot.go", "dsdot_32.go", "Float32"},
+}
+
+const buildTag = "//go:build cgo && unix\n\n"
+
+var (
+	protoRE = regexp.MustCompile(`(?s)\b(void|float|double|CBLAS_INDEX)\s+(cblas_\w+)\s*\(([^;]*?)\)\s*;`)
+	callRE  = regexp.MustCompile(`C\.(cblas_\w+)\(`)
+	funcRE  = regexp.MustCompile(`(?m)^func ([A-Z]\w*)\(`)
+	paramRE = regexp.MustCompile(`(\w+)\s*(\[\])?$`)
+	spaceRE = regexp.MustCompile(`\s+`)
+)
+
+type prototype struct {
+	ret    string
+	params string
+	args   []string
+}
+
+func main() {
+	header, err := os.ReadFile(filepath.Join("..", "cblas.h"))
+	if err != nil {
+		fmt.Printf("Error reading cblas.h: %v\n", err)
+		return
+	}
+	protos := make(map[string]prototype)
+	for _, m := range protoRE.FindAllStringSubmatch(string(header), -1) {
+		params := spaceRE.ReplaceAllString(strings.TrimSpace(m[3]), " ")
+		var args []string
+		for _, p := range strings.Split(params, ",") {
+			arg := paramRE.FindStringSubmatch(strings.TrimSpace(p))
+			if arg == nil {
+				// Not a function we can call through a trampoline.
+				args = nil
+				break
+			}
+			args = append(args, arg[1])
+		}
+		if args != nil {
+			protos[m[2]] = prototype{ret: m[1], params: params, args: args}
+		}
+	}
+
+	var symbols []string
+	seen := make(map[string]bool)
+	for _, s := range sources {
+		content, err := os.ReadFile(s.src)
+		if err != nil {
+			fmt.Printf("Error reading %s: %v\n", s.src, err)
+			return
+		}
+		result := string(content)
+
+		// Trampolines for the C functions called from this file.
+		var tramp strings.Builder
+		used := make(map[string]bool)
+		for _, m := range callRE.FindAllStringSubmatch(result, -1) {
+			name := m[1]
+			if used[name] {
+				continue
+			}
+			used[name] = true
+			if !seen[name] {
+				seen[name] = true
+				symbols = append(symbols, name)
+			}
+			p, ok := protos[name]
+			if !ok {
+				fmt.Printf("No prototype for %s in cblas.h\n", name)
+				return
+			}
+			ret := "return "
+			if p.ret == "void" {
+				ret = ""
+			}
+			fmt.Fprintf(&tramp, "static %s dl_%s(void *fn, %s) {\n\t%s((__typeof__(&%s))fn)(%s);\n}\n",
+				p.ret, name, p.params, ret, name, strings.Join(p.args, ", "))
+		}
+
+		result = regexp.MustCompile(`^package \w+`).ReplaceAllString(result, "package cblasdl")
+		result = strings.Replace(result, `#include "../cblas.h"`+"\n", `#include "../cblas.h"`+"\n\n"+tramp.String(), 1)
+		result = funcRE.ReplaceAllString(result, "func (impl "+s.recv+") $1(")
+		result = callRE.ReplaceAllStringFunc(result, func(call string) string {
+			name := callRE.FindStringSubmatch(call)[1]
+			return "C.dl_" + name + "(impl.lib.syms[" + symConst(name) + "], "
+		})
+
+		dstPath := s.dst
+		fmt.Printf("Generating %s from %s\n", dstPath, s.src)
+		if err := os.WriteFile(dstPath, []byte(buildTag+result), 0644); err != nil {
+			fmt.Printf("Error writing %s: %v\n", dstPath, err)
+			return
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(buildTag)
+	b.WriteString("package cblasdl\n\n")
+	b.WriteString("// Indices into Library.syms of the CBLAS functions called by this package.\nconst (\n")
+	for i, name := range symbols {
+		if i == 0 {
+			fmt.Fprintf(&b, "\t%s = iota\n", symConst(name))
+			continue
+		}
+		fmt.Fprintf(&b, "\t%s\n", symConst(name))
+	}
+	b.WriteString("\n\tnumSymbols\n)\n\n")
+	b.WriteString("// symbolNames holds the C name of each symbol.\nvar symbolNames = [numSymbols]string{\n")
+	for _, name := range symbols {
+		fmt.Fprintf(&b, "\t%s: %q,\n", symConst(name), name)
+	}
+	b.WriteString("}\n")
+	fmt.Println("Generating symbols.go")
+	src, err := format.Source([]byte(b.String()))
+	if err != nil {
+		fmt.Printf("Error formatting symbols.go: %v\n", err)
+		return
+	}
+	if err := os.WriteFile("symbols.go", src, 0644); err != nil {
+		fmt.Printf("Error writing symbols.go: %v\n", err)
+	}
+}
+
+// symConst returns the Go constant naming the index of the C function name.
+func symConst(name string) string {
+	name = strings.TrimPrefix(name, "cblas_")
+	return "sym" + strings.ToUpper(name[:1]) + name[1:]
+}
@@ -0,0 +1,450 @@
+//go:build cgo && unix
+
+package cblasdl
+
+/*
+#include "../cblas.h"
+
+static void dl_cblas_saxpy(void *fn, const CBLAS_INT N, const float alpha, const float *X, const CBLAS_INT incX, float *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_saxpy))fn)(N, alpha, X, incX, Y, incY);
+}
+static void dl_cblas_sscal(void *fn, const CBLAS_INT N, const float alpha, float *X, const CBLAS_INT incX) {
+	((__typeof__(&cblas_sscal))fn)(N, alpha, X, incX);
+}
+static void dl_cblas_scopy(void *fn, const CBLAS_INT N, const float *X, const CBLAS_INT incX, float *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_scopy))fn)(N, X, incX, Y, incY);
+}
+static void dl_cblas_sswap(void *fn, const CBLAS_INT N, float *X, const CBLAS_INT incX, float *Y, const CBLAS_INT incY) {
+	((__typeof__(&cblas_sswap))fn)(N, X, incX, Y, incY);
+}
+static float dl_cblas_sdot(void *fn, const CBLAS_INT N, const float *X, const CBLAS_INT incX, const float *Y, const CBLAS_INT incY) {
+	return ((__typeof__(&cblas_sdot))fn)(N, X, incX, Y, incY);
+}
+static float dl_cblas_snrm2(void *fn, const CBLAS_INT N, const float *X, const CBLAS_INT incX) {
+	return ((__typeof__(&cblas_snrm2))fn)(N, X, incX);
+}
+static float dl_cblas_sasum(void *fn, const CBLAS_INT N, const float *X, const CBLAS_INT incX) {
+	return ((__typeof__(&cblas_sasum))fn)(N, X, incX);
+}
+static CBLAS_INDEX dl_cblas_isamax(void *fn, const CBLAS_INT N, const float *X, const CBLAS_INT incX) {
+	return ((__typeof__(&cblas_isamax))fn)(N, X, incX);
+}
+static void dl_cblas_srotg(void *fn, float *a, float *b, float *c, float *s) {
+	((__typeof__(&cblas_srotg))fn)(a, b, c, s);
+}
+static void dl_cblas_srot(void *fn, const CBLAS_INT N, float *X, const CBLAS_INT incX, float *Y, const CBLAS_INT incY, const float c, const float s) {
+	((__typeof__(&cblas_srot))fn)(N, X, incX, Y, incY, c, s);
+}
+static void dl_cblas_srotmg(void *fn, float *d1, float *d2, float *b1, const float b2, float *P) {
+	((__typeof__(&cblas_srotmg))fn)(d1, d2, b1, b2, P);
+}
+static void dl_cblas_srotm(void *fn, const CBLAS_INT N, float *X, const CBLAS_INT incX, float *Y, const CBLAS_INT incY, const float *P) {
+	((__typeof__(&cblas_srotm))fn)(N, X, incX, Y, incY, P);
+}
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+// y[i] += alpha * x[i] for all i
+func (impl Float32) Axpy(n int, alpha float32, x []float32, incX int, y []float32, incY int) {
+	// declared at cblas.h:112:6 void cblas_saxpy ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(blas.ErrShortY)
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float32
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.dl_cblas_saxpy(impl.lib.syms[symSaxpy], C.int(n), C.float(alpha), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY))
+}
+
+// Scal scales x by alpha.
+//
+//	x[i] *= alpha
+//
+// Scal has no effect if incX < 0.
+func (impl Float32) Scal(n int, alpha float32, x []float32, incX int) {
+	// declared at cblas.h:152:6 void cblas_sscal ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 || incX < 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(x) <= (n-1)*incX {
+		panic(blas.ErrShortX)
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	C.dl_cblas_sscal(impl.lib.syms[symSscal], C.int(n), C.float(alpha), (*C.float)(_x), C.int(incX))
+}
+
+// Copy copies the elements of x into the elements of y.
+//
+//	y[i] = x[i] for all i
+func (impl Float32) Copy(n int, x []float32, incX int, y []float32, incY int) {
+	// declared at cblas.h:110:6 void cblas_scopy ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(blas.ErrShortY)
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float32
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.dl_cblas_scopy(impl.lib.syms[symScopy], C.int(n), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY))
+}
+
+// Swap exchanges the elements of two vectors.
+//
+//	x[i], y[i] = y[i], x[i] for all i
+func (impl Float32) Swap(n int, x []float32, incX int, y []float32, incY int) {
+	// declared at cblas.h:108:6 void cblas_sswap ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(blas.ErrShortY)
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float32
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.dl_cblas_sswap(impl.lib.syms[symSswap], C.int(n), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY))
+}
+
+// Dot computes the dot product of the two vectors
+//
+//	\sum_i x[i]*y[i]
+func (impl Float32) Dot(n int, x []float32, incX int, y []float32, incY int) float32 {
+	// declared at cblas.h:51:8 double cblas_sdot ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(blas.ErrShortY)
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float32
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	return float32(C.dl_cblas_sdot(impl.lib.syms[symSdot], C.int(n), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY)))
+}
+
+// Nrm2 computes the Euclidean norm of a vector,
+//
+//	sqrt(\sum_i x[i] * x[i]).
+//
+// This function returns 0 if incX is negative.
+func (impl Float32) Nrm2(n int, x []float32, incX int) float32 {
+	// declared at cblas.h:74:8 double cblas_snrm2 ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 || incX < 0 {
+		return 0
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(x) <= (n-1)*incX {
+		panic(blas.ErrShortX)
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	return float32(C.dl_cblas_snrm2(impl.lib.syms[symSnrm2], C.int(n), (*C.float)(_x), C.int(incX)))
+}
+
+// Asum computes the sum of the absolute values of the elements of x.
+//
+//	\sum_i |x[i]|
+//
+// Asum returns 0 if incX is negative.
+func (impl Float32) Asum(n int, x []float32, incX int) float32 {
+	// declared at cblas.h:75:8 double cblas_sasum ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 || incX < 0 {
+		return 0
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(x) <= (n-1)*incX {
+		panic(blas.ErrShortX)
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	return float32(C.dl_cblas_sasum(impl.lib.syms[symSasum], C.int(n), (*C.float)(_x), C.int(incX)))
+}
+
+// Iamax returns the index of an element of x with the largest absolute value.
+// If there are multiple such indices the earliest is returned.
+// Iamax returns -1 if n == 0.
+func (impl Float32) Iamax(n int, x []float32, incX int) int {
+	// declared at cblas.h:88:13 unsigned long cblas_isamax ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 || incX < 0 {
+		return -1
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if len(x) <= (n-1)*incX {
+		panic(blas.ErrShortX)
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	return int(C.dl_cblas_isamax(impl.lib.syms[symIsamax], C.int(n), (*C.float)(_x), C.int(incX)))
+}
+
+// Rotg computes a plane rotation
+//
+//	⎡  c s ⎤ ⎡ a ⎤ = ⎡ r ⎤
+//	⎣ -s c ⎦ ⎣ b ⎦   ⎣ 0 ⎦
+//
+// satisfying c^2 + s^2 = 1.
+//
+// The computation uses the formulas
+//
+//	sigma = sgn(a)    if |a| >  |b|
+//	      = sgn(b)    if |b| >= |a|
+//	r = sigma*sqrt(a^2 + b^2)
+//	c = 1; s = 0      if r = 0
+//	c = a/r; s = b/r  if r != 0
+//	c >= 0            if |a| > |b|
+//
+// The subroutine also computes
+//
+//	z = s    if |a| > |b|,
+//	  = 1/c  if |b| >= |a| and c != 0
+//	  = 1    if c = 0
+//
+// This allows c and s to be reconstructed from z as follows:
+//
+//	If z = 1, set c = 0, s = 1.
+//	If |z| < 1, set c = sqrt(1 - z^2) and s = z.
+//	If |z| > 1, set c = 1/z and s = sqrt(1 - c^2).
+//
+// NOTE: There is a discrepancy between the reference implementation and the
+// BLAS technical manual regarding the sign for r when a or b are zero. Rotg
+// agrees with the definition in the manual and other common BLAS
+// implementations.
+func (impl Float32) Rotg(a, b float32) (c, s, r, z float32) {
+	C.dl_cblas_srotg(impl.lib.syms[symSrotg], (*C.float)(&a), (*C.float)(&b), (*C.float)(&c), (*C.float)(&s))
+	return c, s, a, b
+}
+
+// Rot applies a plane transformation.
+//
+//	x[i] = c * x[i] + s * y[i]
+//	y[i] = c * y[i] - s * x[i]
+func (impl Float32) Rot(n int, x []float32, incX int, y []float32, incY int, c float32, s float32) {
+	// declared at cblas.h:142:6 void cblas_srot ...
+
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(blas.ErrShortY)
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float32
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.dl_cblas_srot(impl.lib.syms[symSrot], C.int(n), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY), C.float(c), C.float(s))
+}
+
+// Rotmg computes the modified Givens rotation. See
+// http://www.netlib.org/lapack/explore-html/df/deb/drotmg_8f.html
+// for more details.
+func (impl Float32) Rotmg(d1, d2, x1, y1 float32) (p blas.SrotmParams, rd1, rd2, rx1 float32) {
+	C.dl_cblas_srotmg(impl.lib.syms[symSrotmg], (*C.float)(&d1), (*C.float)(&d2), (*C.float)(&x1), C.float(y1), (*C.float)(unsafe.Pointer(&p)))
+	return p, d1, d2, x1
+}
+
+// Rotm applies the modified Givens rotation to the 2×n matrix.
+func (impl Float32) Rotm(n int, x []float32, incX int, y []float32, incY int, p blas.SrotmParams) {
+	if n < 0 {
+		panic(blas.ErrNLT0)
+	}
+	if incX == 0 {
+		panic(blas.ErrZeroIncX)
+	}
+	if incY == 0 {
+		panic(blas.ErrZeroIncY)
+	}
+	if p.Flag < blas.Identity || p.Flag > blas.Diagonal {
+		panic(blas.ErrBadFlag)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	// For zero matrix size the following slice length checks are trivially satisfied.
+	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
+		panic(blas.ErrShortX)
+	}
+	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
+		panic(blas.ErrShortY)
+	}
+	var _x *float32
+	if len(x) > 0 {
+		_x = &x[0]
+	}
+	var _y *float32
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	C.dl_cblas_srotm(impl.lib.syms[symSrotm], C.int(n), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY), (*C.float)(unsafe.Pointer(&p)))
+}
@@ -0,0 +1,153 @@
+//go:build cgo && unix
+
+package cblasdl
+
+// Indices into Library.syms of the CBLAS functions called by this package.
+const (
+	symDaxpy = iota
+	symDscal
+	symDcopy
+	symDswap
+	symDdot
+	symDnrm2
+	symDasum
+	symIdamax
+	symDrotg
+	symDrot
+	symDrotmg
+	symDrotm
+	symDgemv
+	symDsymv
+	symDtrmv
+	symDtrsv
+	symDger
+	symDsyr
+	symDsyr2
+	symDgbmv
+	symDsbmv
+	symDtbmv
+	symDtbsv
+	symDspmv
+	symDtpmv
+	symDtpsv
+	symDspr
+	symDspr2
+	symDgemm
+	symDsymm
+	symDtrmm
+	symDtrsm
+	symDsyrk
+	symDsyr2k
+	symSaxpy
+	symSscal
+	symScopy
+	symSswap
+	symSdot
+	symSnrm2
+	symSasum
+	symIsamax
+	symSrotg
+	symSrot
+	symSrotmg
+	symSrotm
+	symSgemv
+	symSsymv
+	symStrmv
+	symStrsv
+	symSger
+	symSsyr
+	symSsyr2
+	symSgbmv
+	symSsbmv
+	symStbmv
+	symStbsv
+	symSspmv
+	symStpmv
+	symStpsv
+	symSspr
+	symSspr2
+	symSgemm
+	symSsymm
+	symStrmm
+	symStrsm
+	symSsyrk
+	symSsyr2k
+	symSdsdot
+	symDsdot
+
+	numSymbols
+)
+
+// symbolNames holds the C name of each symbol.
+var symbolNames = [numSymbols]string{
+	symDaxpy:  "cblas_daxpy",
+	symDscal:  "cblas_dscal",
+	symDcopy:  "cblas_dcopy",
+	symDswap:  "cblas_dswap",
+	symDdot:   "cblas_ddot",
+	symDnrm2:  "cblas_dnrm2",
+	symDasum:  "cblas_dasum",
+	symIdamax: "cblas_idamax",
+	symDrotg:  "cblas_drotg",
+	symDrot:   "cblas_drot",
+	symDrotmg: "cblas_drotmg",
+	symDrotm:  "cblas_drotm",
+	symDgemv:  "cblas_dgemv",
+	symDsymv:  "cblas_dsymv",
+	symDtrmv:  "cblas_dtrmv",
+	symDtrsv:  "cblas_dtrsv",
+	symDger:   "cblas_dger",
+	symDsyr:   "cblas_dsyr",
+	symDsyr2:  "cblas_dsyr2",
+	symDgbmv:  "cblas_dgbmv",
+	symDsbmv:  "cblas_dsbmv",
+	symDtbmv:  "cblas_dtbmv",
+	symDtbsv:  "cblas_dtbsv",
+	symDspmv:  "cblas_dspmv",
+	symDtpmv:  "cblas_dtpmv",
+	symDtpsv:  "cblas_dtpsv",
+	symDspr:   "cblas_dspr",
+	symDspr2:  "cblas_dspr2",
+	symDgemm:  "cblas_dgemm",
+	symDsymm:  "cblas_dsymm",
+	symDtrmm:  "cblas_dtrmm",
+	symDtrsm:  "cblas_dtrsm",
+	symDsyrk:  "cblas_dsyrk",
+	symDsyr2k: "cblas_dsyr2k",
+	symSaxpy:  "cblas_saxpy",
+	symSscal:  "cblas_sscal",
+	symScopy:  "cblas_scopy",
+	symSswap:  "cblas_sswap",
+	symSdot:   "cblas_sdot",
+	symSnrm2:  "cblas_snrm2",
+	symSasum:  "cblas_sasum",
+	symIsamax: "cblas_isamax",
+	symSrotg:  "cblas_srotg",
+	symSrot:   "cblas_srot",
+	symSrotmg: "cblas_srotmg",
+	symSrotm:  "cblas_srotm",
+	symSgemv:  "cblas_sgemv",
+	symSsymv:  "cblas_ssymv",
+	symStrmv:  "cblas_strmv",
+	symStrsv:  "cblas_strsv",
+	symSger:   "cblas_sger",
+	symSsyr:   "cblas_ssyr",
+	symSsyr2:  "cblas_ssyr2",
+	symSgbmv:  "cblas_sgbmv",
+	symSsbmv:  "cblas_ssbmv",
+	symStbmv:  "cblas_stbmv",
+	symStbsv:  "cblas_stbsv",
+	symSspmv:  "cblas_sspmv",
+	symStpmv:  "cblas_stpmv",
+	symStpsv:  "cblas_stpsv",
+	symSspr:   "cblas_sspr",
+	symSspr2:  "cblas_sspr2",
+	symSgemm:  "cblas_sgemm",
+	symSsymm:  "cblas_ssymm",
+	symStrmm:  "cblas_strmm",
+	symStrsm:  "cblas_strsm",
+	symSsyrk:  "cblas_ssyrk",
+	symSsyr2k: "cblas_ssyr2k",
+	symSdsdot: "cblas_sdsdot",
+	symDsdot:  "cblas_dsdot",
+}
@@ -10,12 +10,16 @@ import (
 	"strings"
 )
 
-// Replacement rules for converting cblas64 to cblas32
-var replacements = []struct {
+// replacement is a rule applied to the source text, either a plain string
+// replacement or a regular expression.
+type replacement struct {
 	pattern string
 	replace string
 	isRegex bool
-}{
+}
+
+// Replacement rules for converting cblas64 to cblas32
+var replacements = []replacement{
 	// Package name (must be first)
 	{"package cblas64", "package cblas32", false},
 
@@ -36,13 +40,145 @@ var replacements = []struct {
 	{"float64", "float32", false},
 }
 
+// Replacement rules for converting cblasc128 to cblasc64
+var complexReplacements = []replacement{
+	// Package name (must be first)
+	{"package cblasc128", "package cblasc64", false},
+
+	// Special cases for the real valued routines (must come before general
+	// cblas_z* rule)
+	{"cblas_izamax", "cblas_icamax", false},
+	{"cblas_dznrm2", "cblas_scnrm2", false},
+	{"cblas_dzasum", "cblas_scasum", false},
+	{"cblas_zdscal", "cblas_csscal", false},
+
+	// C function calls - convert cblas_z* to cblas_c*
+	{`cblas_z([a-z0-9_]+)`, `cblas_c$1`, true},
+
+	// Scaling by a real number
+	{`\bDscal\b`, "Sscal", true},
+
+	// C type conversions
+	{"C.double", "C.float", false},
+
+	// Type replacements (must be last to avoid conflicts)
+	{"float64", "float32", false},
+	{"complex128", "complex64", false},
+}
+
+// Packages to generate, each from the files of a double precision package.
+var targets = []struct {
+	srcDir       string
+	dstDir       string
+	files        []string
+	replacements []replacement
+}{
+	{"cblas64", "cblas32", []string{"level1.go", "level2.go", "level3.go"}, replacements},
+	{"cblasc128", "cblasc64", []string{"level1.go", "level2.go", "level3.go"}, complexReplacements},
+}
+
+// The mixed precision routines Sdsdot and Dsdot have no double precision
+// counterpart. They are derived from the single precision Dot, with these
+// replacements applied after the cblas32 ones.
+var mixedReplacements = []struct {
+	name         string
+	replacements []replacement
+}{
+	{"Sdsdot", []replacement{
+		{`(?s)// Dot computes.*?\n(func)`, "// Sdsdot computes the dot product of the two vectors plus a constant\n//\n//\talpha + \\sum_i x[i]*y[i]\n//\n// The dot product is accumulated in double precision.\n$1", true},
+		{"func Dot(n int, ", "func Sdsdot(n int, alpha float32, ", false},
+		{"return 0\n", "return alpha\n", false},
+		{"C.cblas_sdot(C.int(n), ", "C.cblas_sdsdot(C.int(n), C.float(alpha), ", false},
+	}},
+	{"Dsdot", []replacement{
+		{`(?s)// Dot computes.*?\n(func)`, "// Dsdot computes the dot product of the two vectors\n//\n//\t\\sum_i x[i]*y[i]\n//\n// The dot product is accumulated and returned in double precision.\n$1", true},
+		{"func Dot(", "func Dsdot(", false},
+		{") float32 {", ") float64 {", false},
+		{"return float32(C.cblas_sdot(", "return float64(C.cblas_dsdot(", false},
+	}},
+}
+
 func main() {
-	// Source and destination directories
-	srcDir := "cblas64"
-	dstDir := "cblas32"
+	for _, t := range targets {
+		generate(t.srcDir, t.dstDir, t.files, t.replacements)
+	}
+	generateMixed(filepath.Join("cblas64", "level1.go"), filepath.Join("cblas32", "dsdot.go"))
+}
+
+// generateMixed writes the mixed precision routines to dstPath, deriving them
+// from Dot in the double precision level 1 source srcPath.
+func generateMixed(srcPath, dstPath string) {
+	fmt.Printf("Generating %s from %s\n", dstPath, srcPath)
+
+	content, err := os.ReadFile(srcPath)
+	if err != nil {
+		fmt.Printf("Error reading %s: %v\n", srcPath, err)
+		return
+	}
+	dot := regexp.MustCompile(`(?s)// Dot computes.*?\n}\n`).FindString(string(content))
+	if dot == "" {
+		fmt.Printf("Error: Dot not found in %s\n", srcPath)
+		return
+	}
+	dot = apply(dot, replacements)
+
+	header, err := os.ReadFile("cblas.h")
+	if err != nil {
+		fmt.Printf("Error reading cblas.h: %v\n", err)
+		return
+	}
+
+	var b strings.Builder
+	b.WriteString("package cblas32\n\n/*\n#include \"../cblas.h\"\n*/\nimport \"C\"\n\nimport \"github.com/gocnn/gomat/blas\"\n")
+	for _, r := range mixedReplacements {
+		f := apply(dot, r.replacements)
+		// Point the declaration comment at the C routine that is called.
+		cname := "cblas_" + strings.ToLower(r.name)
+		f = regexp.MustCompile(`declared at cblas\.h:\d+:\d+ \w+ cblas_sdot`).ReplaceAllString(f, "declared at "+declaration(string(header), cname))
+		b.WriteString("\n" + f)
+	}
 
-	// Files to generate - now includes cblas.go
-	files := []string{"cblas.go", "level1.go", "level2.go", "level3.go"}
+	if err := os.WriteFile(dstPath, []byte(b.String()), 0644); err != nil {
+		fmt.Printf("Error writing %s: %v\n", dstPath, err)
+		return
+	}
+	fmt.Printf("Successfully generated %s\n", dstPath)
+}
+
+// declaration returns the position and return type of the declaration of the
+// C routine name in the header, in the form "cblas.h:line:column type name".
+func declaration(header, name string) string {
+	for i, line := range strings.Split(header, "\n") {
+		col := strings.Index(line, name+"(")
+		if col < 0 {
+			continue
+		}
+		return fmt.Sprintf("cblas.h:%d:%d %s %s", i+1, col+1, strings.Fields(line)[0], name)
+	}
+	return "cblas.h " + name
+}
+
+// apply returns src with the replacements applied in order.
+func apply(src string, replacements []replacement) string {
+	for _, repl := range replacements {
+		if repl.isRegex {
+			// Use regex for patterns with special regex syntax
+			re := regexp.MustCompile(repl.pattern)
+			src = re.ReplaceAllString(src, repl.replace)
+		} else {
+			// Simple string replacement
+			src = strings.ReplaceAll(src, repl.pattern, repl.replace)
+		}
+	}
+	return src
+}
+
+func generate(srcDir, dstDir string, files []string, replacements []replacement) {
+	// Create destination directory if it doesn't exist
+	if err := os.MkdirAll(dstDir, 0755); err != nil {
+		fmt.Printf("Error creating directory %s: %v\n", dstDir, err)
+		return
+	}
 
 	for _, file := range files {
 		srcPath := filepath.Join(srcDir, file)
@@ -58,17 +194,7 @@ func main() {
 		}
 
 		// Apply replacements in order
-		result := string(content)
-		for _, repl := range replacements {
-			if repl.isRegex {
-				// Use regex for patterns with special regex syntax
-				re := regexp.MustCompile(repl.pattern)
-				result = re.ReplaceAllString(result, repl.replace)
-			} else {
-				// Simple string replacement
-				result = strings.ReplaceAll(result, repl.pattern, repl.replace)
-			}
-		}
+		result := apply(string(content), replacements)
 
 		// Write destination file
 		err = os.WriteFile(dstPath, []byte(result), 0644)
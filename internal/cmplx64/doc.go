@@ -0,0 +1,7 @@
+// Copyright ©2017 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cmplx64 provides complex64 versions of standard library math/cmplx
+// package routines used by the complex BLAS.
+package cmplx64
@@ -0,0 +1,9 @@
+package cpu
+
+// X86 contains the features of an x86 processor that are used by the
+// assembly kernels. The fields are set at initialization and are false on
+// other architectures, or when assembly is disabled.
+var X86 struct {
+	HasAVX2 bool // AVX2 instructions, with the YMM registers enabled by the OS
+	HasFMA  bool // Fused multiply-add instructions
+}
@@ -0,0 +1,34 @@
+//go:build !noasm && !gccgo && !safe
+
+package cpu
+
+func init() {
+	maxID, _, _, _ := cpuid(0, 0)
+	if maxID < 7 {
+		return
+	}
+	_, _, ecx1, _ := cpuid(1, 0)
+	const (
+		fma     = 1 << 12
+		osxsave = 1 << 27
+		avx     = 1 << 28
+	)
+	if ecx1&(osxsave|avx) != osxsave|avx {
+		return
+	}
+	// The operating system must save the XMM and YMM registers on context
+	// switches for the AVX instructions to be usable.
+	if eax, _ := xgetbv(); eax&0x6 != 0x6 {
+		return
+	}
+	_, ebx7, _, _ := cpuid(7, 0)
+	const avx2 = 1 << 5
+	X86.HasAVX2 = ebx7&avx2 != 0
+	X86.HasFMA = ecx1&fma != 0
+}
+
+// cpuid executes the CPUID instruction for the leaf eaxArg and sub-leaf ecxArg.
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+// xgetbv returns the extended control register XCR0.
+func xgetbv() (eax, edx uint32)
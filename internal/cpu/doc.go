@@ -0,0 +1,3 @@
+// Package cpu detects the processor features used to select assembly kernels
+// at run time.
+package cpu
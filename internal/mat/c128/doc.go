@@ -0,0 +1,2 @@
+// Package c128 provides complex128 vector primitives.
+package c128
@@ -0,0 +1,2 @@
+// Package c64 provides complex64 vector primitives.
+package c64
@@ -0,0 +1,55 @@
+package f32
+
+// GemmMaxMR and GemmMaxNR bound GemmMR and GemmNR for all the micro-kernels.
+const (
+	GemmMaxMR = 6
+	GemmMaxNR = 16
+)
+
+// GemmMR and GemmNR are the number of rows and columns of the block of C
+// updated by a single call to GemmKernel. They are set at initialization to
+// the block size of the micro-kernel selected for the processor.
+var (
+	GemmMR = gemmGoMR
+	GemmNR = gemmGoNR
+)
+
+// GemmKernel performs the register blocked matrix multiplication
+//
+//	C += A * B
+//
+// where C is a GemmMR×GemmNR matrix with row stride ldc, A is a GemmMR×k
+// matrix packed column by column into a, and B is a k×GemmNR matrix packed
+// row by row into b.
+var GemmKernel = gemmKernelGo
+
+// gemmGoMR and gemmGoNR are the block size of gemmKernelGo.
+const (
+	gemmGoMR = 4
+	gemmGoNR = 8
+)
+
+// gemmKernelGo is the pure Go GemmKernel, used when no assembly kernel is
+// available.
+func gemmKernelGo(k int, a, b, c []float32, ldc int) {
+	var c0, c1, c2, c3 [gemmGoNR]float32
+	a = a[:gemmGoMR*k]
+	b = b[:gemmGoNR*k]
+	for p := 0; p < k; p++ {
+		ap := (*[gemmGoMR]float32)(a[p*gemmGoMR:])
+		bp := (*[gemmGoNR]float32)(b[p*gemmGoNR:])
+		a0, a1, a2, a3 := ap[0], ap[1], ap[2], ap[3]
+		for j, v := range bp {
+			c0[j] += a0 * v
+			c1[j] += a1 * v
+			c2[j] += a2 * v
+			c3[j] += a3 * v
+		}
+	}
+	for i, ci := range [gemmGoMR]*[gemmGoNR]float32{&c0, &c1, &c2, &c3} {
+		r := (*[gemmGoNR]float32)(c[i*ldc : i*ldc+gemmGoNR])
+		for j, v := range ci {
+			r[j] += v
+		}
+	}
+}
@@ -0,0 +1,23 @@
+//go:build !noasm && !gccgo && !safe
+
+package f32
+
+import "github.com/gocnn/gomat/internal/cpu"
+
+func init() {
+	if cpu.X86.HasAVX2 && cpu.X86.HasFMA {
+		GemmKernel, GemmMR, GemmNR = gemmKernelAVX2, 6, 16
+		return
+	}
+	GemmKernel, GemmMR, GemmNR = gemmKernelSSE, 4, 8
+}
+
+// gemmKernelSSE is GemmKernel for a 4×8 block of C using SSE instructions.
+// This function assumes len(a) >= 4*k, len(b) >= 8*k and
+// len(c) >= 3*ldc+8.
+func gemmKernelSSE(k int, a, b, c []float32, ldc int)
+
+// gemmKernelAVX2 is GemmKernel for a 6×16 block of C using AVX2 and FMA
+// instructions. This function assumes len(a) >= 6*k, len(b) >= 16*k and
+// len(c) >= 5*ldc+16.
+func gemmKernelAVX2(k int, a, b, c []float32, ldc int)
@@ -0,0 +1,73 @@
+#include "textflag.h"
+
+#define K CX
+#define A_PTR SI
+#define B_PTR DI
+#define C_PTR DX
+#define LDC BX
+
+// ROW multiplies the packed element of A at off(A_PTR), broadcast to all
+// lanes, by the row of B held in X8 and X9, and adds the product to the
+// accumulators c0 and c1 of a row of C, using t0 and t1 as temporaries.
+#define ROW(off, t0, t1, c0, c1) \
+	MOVSS  off(A_PTR), t0 \
+	SHUFPS $0, t0, t0     \
+	MOVAPS t0, t1         \
+	MULPS  X8, t0         \
+	MULPS  X9, t1         \
+	ADDPS  t0, c0         \
+	ADDPS  t1, c1
+
+// STORE adds the accumulators c0 and c1 to the row of C at C_PTR.
+#define STORE(c0, c1) \
+	MOVUPS (C_PTR), X8   \
+	MOVUPS 16(C_PTR), X9 \
+	ADDPS  c0, X8        \
+	ADDPS  c1, X9        \
+	MOVUPS X8, (C_PTR)   \
+	MOVUPS X9, 16(C_PTR)
+
+// func gemmKernelSSE(k int, a, b, c []float32, ldc int)
+TEXT ·gemmKernelSSE(SB), NOSPLIT, $0
+	MOVQ k+0(FP), K
+	MOVQ a_base+8(FP), A_PTR
+	MOVQ b_base+32(FP), B_PTR
+	MOVQ c_base+56(FP), C_PTR
+	MOVQ ldc+80(FP), LDC
+	SHLQ $2, LDC             // LDC *= sizeof(float32)
+
+	// The 4×8 block of C is accumulated four columns at a time in X0-X7.
+	XORPS X0, X0
+	XORPS X1, X1
+	XORPS X2, X2
+	XORPS X3, X3
+	XORPS X4, X4
+	XORPS X5, X5
+	XORPS X6, X6
+	XORPS X7, X7
+
+	CMPQ K, $0
+	JLE  gemm_store // if k <= 0 { goto gemm_store }
+
+gemm_loop: // do {
+	MOVUPS (B_PTR), X8   // X8 = b[p*8:p*8+4]
+	MOVUPS 16(B_PTR), X9 // X9 = b[p*8+4:p*8+8]
+	ROW(0, X10, X11, X0, X1)
+	ROW(4, X12, X13, X2, X3)
+	ROW(8, X14, X15, X4, X5)
+	ROW(12, X10, X11, X6, X7)
+
+	ADDQ $16, A_PTR // a = a[4:]
+	ADDQ $32, B_PTR // b = b[8:]
+	DECQ K
+	JNZ  gemm_loop  // } while --k > 0
+
+gemm_store:
+	STORE(X0, X1)
+	ADDQ LDC, C_PTR
+	STORE(X2, X3)
+	ADDQ LDC, C_PTR
+	STORE(X4, X5)
+	ADDQ LDC, C_PTR
+	STORE(X6, X7)
+	RET
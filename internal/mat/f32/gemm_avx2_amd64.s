@@ -0,0 +1,78 @@
+#include "textflag.h"
+
+#define K CX
+#define A_PTR SI
+#define B_PTR DI
+#define C_PTR DX
+#define LDC BX
+
+// ROW multiplies the packed element of A at off(A_PTR), broadcast to all
+// lanes, by the row of B held in Y12 and Y13, and adds the product to the
+// accumulators c0 and c1 of a row of C.
+#define ROW(off, t, c0, c1) \
+	VBROADCASTSS off(A_PTR), t \
+	VFMADD231PS  Y12, t, c0    \
+	VFMADD231PS  Y13, t, c1
+
+// STORE adds the accumulators c0 and c1 to the row of C at C_PTR.
+#define STORE(c0, c1) \
+	VADDPS  (C_PTR), c0, c0   \
+	VADDPS  32(C_PTR), c1, c1 \
+	VMOVUPS c0, (C_PTR)       \
+	VMOVUPS c1, 32(C_PTR)
+
+// func gemmKernelAVX2(k int, a, b, c []float32, ldc int)
+TEXT ·gemmKernelAVX2(SB), NOSPLIT, $0
+	MOVQ k+0(FP), K
+	MOVQ a_base+8(FP), A_PTR
+	MOVQ b_base+32(FP), B_PTR
+	MOVQ c_base+56(FP), C_PTR
+	MOVQ ldc+80(FP), LDC
+	SHLQ $2, LDC             // LDC *= sizeof(float32)
+
+	// The 6×16 block of C is accumulated eight columns at a time in Y0-Y11.
+	VXORPS Y0, Y0, Y0
+	VXORPS Y1, Y1, Y1
+	VXORPS Y2, Y2, Y2
+	VXORPS Y3, Y3, Y3
+	VXORPS Y4, Y4, Y4
+	VXORPS Y5, Y5, Y5
+	VXORPS Y6, Y6, Y6
+	VXORPS Y7, Y7, Y7
+	VXORPS Y8, Y8, Y8
+	VXORPS Y9, Y9, Y9
+	VXORPS Y10, Y10, Y10
+	VXORPS Y11, Y11, Y11
+
+	CMPQ K, $0
+	JLE  gemm_store // if k <= 0 { goto gemm_store }
+
+gemm_loop: // do {
+	VMOVUPS (B_PTR), Y12   // Y12 = b[p*16:p*16+8]
+	VMOVUPS 32(B_PTR), Y13 // Y13 = b[p*16+8:p*16+16]
+	ROW(0, Y14, Y0, Y1)
+	ROW(4, Y15, Y2, Y3)
+	ROW(8, Y14, Y4, Y5)
+	ROW(12, Y15, Y6, Y7)
+	ROW(16, Y14, Y8, Y9)
+	ROW(20, Y15, Y10, Y11)
+
+	ADDQ $24, A_PTR // a = a[6:]
+	ADDQ $64, B_PTR // b = b[16:]
+	DECQ K
+	JNZ  gemm_loop  // } while --k > 0
+
+gemm_store:
+	STORE(Y0, Y1)
+	ADDQ LDC, C_PTR
+	STORE(Y2, Y3)
+	ADDQ LDC, C_PTR
+	STORE(Y4, Y5)
+	ADDQ LDC, C_PTR
+	STORE(Y6, Y7)
+	ADDQ LDC, C_PTR
+	STORE(Y8, Y9)
+	ADDQ LDC, C_PTR
+	STORE(Y10, Y11)
+	VZEROUPPER
+	RET
@@ -0,0 +1,16 @@
+package f32
+
+import "github.com/gocnn/gomat/internal/math32"
+
+// L1Norm is
+//
+//	for _, v := range x {
+//		sum += math32.Abs(v)
+//	}
+//	return sum
+func L1Norm(x []float32) (sum float32) {
+	for _, v := range x {
+		sum += math32.Abs(v)
+	}
+	return sum
+}
@@ -101,6 +101,10 @@ tail_one:
 end:
 	RET
 
+// y and dst are distinct slices in AxpyUnitaryTo.
+#undef Y_PTR
+#define Y_PTR DX
+
 // func AxpyUnitaryTo(dst []float64, alpha float64, x, y []float64)
 TEXT ·AxpyUnitaryTo(SB), NOSPLIT, $0
 	MOVQ    dst_base+0(FP), DST_PTR // DST_PTR := &dst
@@ -193,6 +197,9 @@ tail_one:
 end:
 	RET
 
+#undef Y_PTR
+#define Y_PTR DI
+
 // func AxpyInc(alpha float64, x, y []float64, n, incX, incY, ix, iy uintptr)
 TEXT ·AxpyInc(SB), NOSPLIT, $0
 	MOVQ x_base+8(FP), X_PTR  // X_PTR = &x
@@ -239,9 +246,9 @@ loop:  // do {  // y[i] += alpha * x[i] unrolled 4x.
 	ADDSD (Y_PTR)(INCx3_Y*1), X5
 
 	MOVSD X2, (DST_PTR)              // y[i] = X_i
-	MOVSD X3, (DST_PTR)(INC_DST*1)
-	MOVSD X4, (DST_PTR)(INC_DST*2)
-	MOVSD X5, (DST_PTR)(INCx3_DST*1)
+	MOVSD X3, (DST_PTR)(INC_Y*1)
+	MOVSD X4, (DST_PTR)(INC_Y*2)
+	MOVSD X5, (DST_PTR)(INCx3_Y*1)
 
 	LEAQ (X_PTR)(INC_X*4), X_PTR // X_PTR = &(X_PTR[incX*4])
 	LEAQ (Y_PTR)(INC_Y*4), Y_PTR // Y_PTR = &(Y_PTR[incY*4])
@@ -263,7 +270,7 @@ tail_two:
 	ADDSD (Y_PTR), X2              // X_i += y[i]
 	ADDSD (Y_PTR)(INC_Y*1), X3
 	MOVSD X2, (DST_PTR)            // y[i] = X_i
-	MOVSD X3, (DST_PTR)(INC_DST*1)
+	MOVSD X3, (DST_PTR)(INC_Y*1)
 
 	LEAQ (X_PTR)(INC_X*2), X_PTR // X_PTR = &(X_PTR[incX*2])
 	LEAQ (Y_PTR)(INC_Y*2), Y_PTR // Y_PTR = &(Y_PTR[incY*2])
@@ -281,6 +288,10 @@ tail_one:
 end:
 	RET
 
+// y and dst are distinct slices in AxpyIncTo.
+#undef DST_PTR
+#define DST_PTR DX
+
 // func AxpyIncTo(dst []float64, incDst, idst uintptr, alpha float64, x, y []float64, n, incX, incY, ix, iy uintptr)
 TEXT ·AxpyIncTo(SB), NOSPLIT, $0
 	MOVQ dst_base+0(FP), DST_PTR // DST_PTR := &dst
@@ -0,0 +1,190 @@
+package f64
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// The tests in this file check the kernels, which are implemented in
+// assembly on amd64, against straightforward Go loops.
+
+// kernelLens are the vector lengths used in the kernel tests. They cover
+// every remainder of the loop unrolling.
+var kernelLens = []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 15, 16, 17, 31, 32, 33, 100}
+
+// kernelIncs are the increments used in the strided kernel tests.
+var kernelIncs = []int{1, 2, 3, 7, -1, -2, -5}
+
+// guard is the value of the elements around a vector that a kernel must not
+// modify.
+const guard = -999.5
+
+// randomVec returns a vector of length 1+(n-1)*|inc| surrounded by pad guard
+// elements on each side, and the vector without the padding.
+func randomVec(rnd *rand.Rand, n, inc, pad int) (padded, v []float64) {
+	l := 0
+	if n > 0 {
+		l = 1 + (n-1)*abs(inc)
+	}
+	padded = make([]float64, l+2*pad)
+	for i := range padded {
+		padded[i] = guard
+	}
+	v = padded[pad : pad+l]
+	for i := range v {
+		v[i] = 2*rnd.Float64() - 1
+	}
+	return padded, v
+}
+
+// start returns the index of the first element of a strided vector.
+func start(n, inc int) int {
+	if inc < 0 {
+		return (1 - n) * inc
+	}
+	return 0
+}
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+func axpyIncToGo(dst []float64, incDst, idst int, alpha float64, x, y []float64, n, incX, incY, ix, iy int) {
+	for i := 0; i < n; i++ {
+		dst[idst] = alpha*x[ix] + y[iy]
+		ix += incX
+		iy += incY
+		idst += incDst
+	}
+}
+
+// checkGuards reports an error if the pad elements at either end of v have
+// been modified.
+func checkGuards(t *testing.T, name string, v []float64, pad int) {
+	t.Helper()
+	for i := 0; i < pad; i++ {
+		if v[i] != guard || v[len(v)-1-i] != guard {
+			t.Errorf("%s: guard element modified", name)
+			return
+		}
+	}
+}
+
+func TestAxpyUnitary(t *testing.T) {
+	const pad = 4
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range kernelLens {
+		for _, alpha := range []float64{0, 1, -2.5} {
+			_, x := randomVec(rnd, n, 1, pad)
+			yPad, y := randomVec(rnd, n, 1, pad)
+			want := slices.Clone(y)
+			axpyIncToGo(want, 1, 0, alpha, x, want, n, 1, 1, 0, 0)
+
+			AxpyUnitary(alpha, x, y)
+			name := fmt.Sprintf("n=%d,alpha=%v", n, alpha)
+			if !slices.Equal(y, want) {
+				t.Errorf("%s: got %v, want %v", name, y, want)
+			}
+			checkGuards(t, name, yPad, pad)
+		}
+	}
+}
+
+func TestAxpyUnitaryTo(t *testing.T) {
+	const pad = 4
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range kernelLens {
+		for _, alias := range []string{"none", "x", "y"} {
+			const alpha = -2.5
+			_, x := randomVec(rnd, n, 1, pad)
+			_, y := randomVec(rnd, n, 1, pad)
+			dstPad, dst := randomVec(rnd, n, 1, pad)
+			switch alias {
+			case "x":
+				dstPad, dst = nil, x
+			case "y":
+				dstPad, dst = nil, y
+			}
+			want := make([]float64, n)
+			axpyIncToGo(want, 1, 0, alpha, x, y, n, 1, 1, 0, 0)
+
+			AxpyUnitaryTo(dst, alpha, x, y)
+			name := fmt.Sprintf("n=%d,alias=%s", n, alias)
+			if !slices.Equal(dst, want) {
+				t.Errorf("%s: got %v, want %v", name, dst, want)
+			}
+			if dstPad != nil {
+				checkGuards(t, name, dstPad, pad)
+			}
+		}
+	}
+}
+
+func TestAxpyInc(t *testing.T) {
+	const pad = 4
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range kernelLens {
+		for _, incX := range kernelIncs {
+			for _, incY := range kernelIncs {
+				const alpha = -2.5
+				_, x := randomVec(rnd, n, incX, pad)
+				yPad, y := randomVec(rnd, n, incY, pad)
+				ix, iy := start(n, incX), start(n, incY)
+				want := slices.Clone(y)
+				axpyIncToGo(want, incY, iy, alpha, x, want, n, incX, incY, ix, iy)
+
+				AxpyInc(alpha, x, y, uintptr(n), uintptr(incX), uintptr(incY), uintptr(ix), uintptr(iy))
+				name := fmt.Sprintf("n=%d,incX=%d,incY=%d", n, incX, incY)
+				if !slices.Equal(y, want) {
+					t.Errorf("%s: got %v, want %v", name, y, want)
+				}
+				checkGuards(t, name, yPad, pad)
+			}
+		}
+	}
+}
+
+func TestAxpyIncTo(t *testing.T) {
+	const pad = 4
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range kernelLens {
+		for _, incX := range kernelIncs {
+			for _, incY := range kernelIncs {
+				for _, alias := range []string{"none", "x", "y"} {
+					const alpha = -2.5
+					_, x := randomVec(rnd, n, incX, pad)
+					_, y := randomVec(rnd, n, incY, pad)
+					ix, iy := start(n, incX), start(n, incY)
+
+					// When dst is aliased it is stored with the same
+					// increment as the vector it shares.
+					incDst := []int{2, -3}[n%2]
+					dstPad, dst := randomVec(rnd, n, incDst, pad)
+					switch alias {
+					case "x":
+						dstPad, dst, incDst = nil, x, incX
+					case "y":
+						dstPad, dst, incDst = nil, y, incY
+					}
+					idst := start(n, incDst)
+					want := slices.Clone(dst)
+					axpyIncToGo(want, incDst, idst, alpha, slices.Clone(x), slices.Clone(y), n, incX, incY, ix, iy)
+
+					AxpyIncTo(dst, uintptr(incDst), uintptr(idst), alpha, x, y, uintptr(n), uintptr(incX), uintptr(incY), uintptr(ix), uintptr(iy))
+					name := fmt.Sprintf("n=%d,incX=%d,incY=%d,alias=%s", n, incX, incY, alias)
+					if !slices.Equal(dst, want) {
+						t.Errorf("%s: got %v, want %v", name, dst, want)
+					}
+					if dstPad != nil {
+						checkGuards(t, name, dstPad, pad)
+					}
+				}
+			}
+		}
+	}
+}
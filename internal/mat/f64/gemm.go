@@ -0,0 +1,85 @@
+package f64
+
+// GemmMaxMR and GemmMaxNR bound GemmMR and GemmNR for all the micro-kernels.
+const (
+	GemmMaxMR = 4
+	GemmMaxNR = 8
+)
+
+// GemmMR and GemmNR are the number of rows and columns of the block of C
+// updated by a single call to GemmKernel. They are set at initialization to
+// the block size of the micro-kernel selected for the processor.
+var (
+	GemmMR = gemmGoMR
+	GemmNR = gemmGoNR
+)
+
+// GemmKernel performs the register blocked matrix multiplication
+//
+//	C += A * B
+//
+// where C is a GemmMR×GemmNR matrix with row stride ldc, A is a GemmMR×k
+// matrix packed column by column into a, and B is a k×GemmNR matrix packed
+// row by row into b.
+var GemmKernel = gemmKernelGo
+
+// gemmGoMR and gemmGoNR are the block size of gemmKernelGo.
+const (
+	gemmGoMR = 4
+	gemmGoNR = 4
+)
+
+// gemmKernelGo is the pure Go GemmKernel, used when no assembly kernel is
+// available.
+func gemmKernelGo(k int, a, b, c []float64, ldc int) {
+	var (
+		c00, c01, c02, c03 float64
+		c10, c11, c12, c13 float64
+		c20, c21, c22, c23 float64
+		c30, c31, c32, c33 float64
+	)
+	a = a[:gemmGoMR*k]
+	b = b[:gemmGoNR*k]
+	for p := 0; p < k; p++ {
+		ap := (*[gemmGoMR]float64)(a[p*gemmGoMR:])
+		bp := (*[gemmGoNR]float64)(b[p*gemmGoNR:])
+		a0, a1, a2, a3 := ap[0], ap[1], ap[2], ap[3]
+		b0, b1, b2, b3 := bp[0], bp[1], bp[2], bp[3]
+		c00 += a0 * b0
+		c01 += a0 * b1
+		c02 += a0 * b2
+		c03 += a0 * b3
+		c10 += a1 * b0
+		c11 += a1 * b1
+		c12 += a1 * b2
+		c13 += a1 * b3
+		c20 += a2 * b0
+		c21 += a2 * b1
+		c22 += a2 * b2
+		c23 += a2 * b3
+		c30 += a3 * b0
+		c31 += a3 * b1
+		c32 += a3 * b2
+		c33 += a3 * b3
+	}
+	r := (*[gemmGoNR]float64)(c[:gemmGoNR])
+	r[0] += c00
+	r[1] += c01
+	r[2] += c02
+	r[3] += c03
+	r = (*[gemmGoNR]float64)(c[ldc : ldc+gemmGoNR])
+	r[0] += c10
+	r[1] += c11
+	r[2] += c12
+	r[3] += c13
+	r = (*[gemmGoNR]float64)(c[2*ldc : 2*ldc+gemmGoNR])
+	r[0] += c20
+	r[1] += c21
+	r[2] += c22
+	r[3] += c23
+	r = (*[gemmGoNR]float64)(c[3*ldc : 3*ldc+gemmGoNR])
+	r[0] += c30
+	r[1] += c31
+	r[2] += c32
+	r[3] += c33
+}
@@ -0,0 +1,23 @@
+//go:build !noasm && !gccgo && !safe
+
+package f64
+
+import "github.com/gocnn/gomat/internal/cpu"
+
+func init() {
+	if cpu.X86.HasAVX2 && cpu.X86.HasFMA {
+		GemmKernel, GemmMR, GemmNR = gemmKernelAVX2, 4, 8
+		return
+	}
+	GemmKernel, GemmMR, GemmNR = gemmKernelSSE2, 4, 4
+}
+
+// gemmKernelSSE2 is GemmKernel for a 4×4 block of C using SSE2 instructions.
+// This function assumes len(a) >= 4*k, len(b) >= 4*k and
+// len(c) >= 3*ldc+4.
+func gemmKernelSSE2(k int, a, b, c []float64, ldc int)
+
+// gemmKernelAVX2 is GemmKernel for a 4×8 block of C using AVX2 and FMA
+// instructions. This function assumes len(a) >= 4*k, len(b) >= 8*k and
+// len(c) >= 3*ldc+8.
+func gemmKernelAVX2(k int, a, b, c []float64, ldc int)
@@ -0,0 +1,73 @@
+#include "textflag.h"
+
+#define K CX
+#define A_PTR SI
+#define B_PTR DI
+#define C_PTR DX
+#define LDC BX
+
+// ROW multiplies the packed element of A at off(A_PTR), broadcast to both
+// lanes, by the row of B held in X8 and X9, and adds the product to the
+// accumulators c0 and c1 of a row of C, using t0 and t1 as temporaries.
+#define ROW(off, t0, t1, c0, c1) \
+	MOVSD    off(A_PTR), t0 \
+	UNPCKLPD t0, t0         \
+	MOVAPD   t0, t1         \
+	MULPD    X8, t0         \
+	MULPD    X9, t1         \
+	ADDPD    t0, c0         \
+	ADDPD    t1, c1
+
+// STORE adds the accumulators c0 and c1 to the row of C at C_PTR.
+#define STORE(c0, c1) \
+	MOVUPD (C_PTR), X8   \
+	MOVUPD 16(C_PTR), X9 \
+	ADDPD  c0, X8        \
+	ADDPD  c1, X9        \
+	MOVUPD X8, (C_PTR)   \
+	MOVUPD X9, 16(C_PTR)
+
+// func gemmKernelSSE2(k int, a, b, c []float64, ldc int)
+TEXT ·gemmKernelSSE2(SB), NOSPLIT, $0
+	MOVQ k+0(FP), K
+	MOVQ a_base+8(FP), A_PTR
+	MOVQ b_base+32(FP), B_PTR
+	MOVQ c_base+56(FP), C_PTR
+	MOVQ ldc+80(FP), LDC
+	SHLQ $3, LDC             // LDC *= sizeof(float64)
+
+	// The 4×4 block of C is accumulated two columns at a time in X0-X7.
+	XORPD X0, X0
+	XORPD X1, X1
+	XORPD X2, X2
+	XORPD X3, X3
+	XORPD X4, X4
+	XORPD X5, X5
+	XORPD X6, X6
+	XORPD X7, X7
+
+	CMPQ K, $0
+	JLE  gemm_store // if k <= 0 { goto gemm_store }
+
+gemm_loop: // do {
+	MOVUPD (B_PTR), X8   // X8 = b[p*4:p*4+2]
+	MOVUPD 16(B_PTR), X9 // X9 = b[p*4+2:p*4+4]
+	ROW(0, X10, X11, X0, X1)
+	ROW(8, X12, X13, X2, X3)
+	ROW(16, X14, X15, X4, X5)
+	ROW(24, X10, X11, X6, X7)
+
+	ADDQ $32, A_PTR // a = a[4:]
+	ADDQ $32, B_PTR // b = b[4:]
+	DECQ K
+	JNZ  gemm_loop  // } while --k > 0
+
+gemm_store:
+	STORE(X0, X1)
+	ADDQ LDC, C_PTR
+	STORE(X2, X3)
+	ADDQ LDC, C_PTR
+	STORE(X4, X5)
+	ADDQ LDC, C_PTR
+	STORE(X6, X7)
+	RET
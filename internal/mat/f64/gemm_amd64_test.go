@@ -0,0 +1,20 @@
+//go:build !noasm && !gccgo && !safe
+
+package f64
+
+import (
+	"testing"
+
+	"github.com/gocnn/gomat/internal/cpu"
+)
+
+func TestGemmKernelSSE2(t *testing.T) {
+	testGemmKernel(t, gemmKernelSSE2, 4, 4)
+}
+
+func TestGemmKernelAVX2(t *testing.T) {
+	if !cpu.X86.HasAVX2 || !cpu.X86.HasFMA {
+		t.Skip("AVX2 and FMA not supported")
+	}
+	testGemmKernel(t, gemmKernelAVX2, 4, 8)
+}
@@ -0,0 +1,68 @@
+#include "textflag.h"
+
+#define K CX
+#define A_PTR SI
+#define B_PTR DI
+#define C_PTR DX
+#define LDC BX
+
+// ROW multiplies the packed element of A at off(A_PTR), broadcast to all
+// lanes, by the row of B held in Y8 and Y9, and adds the product to the
+// accumulators c0 and c1 of a row of C.
+#define ROW(off, t, c0, c1) \
+	VBROADCASTSD off(A_PTR), t \
+	VFMADD231PD  Y8, t, c0     \
+	VFMADD231PD  Y9, t, c1
+
+// STORE adds the accumulators c0 and c1 to the row of C at C_PTR.
+#define STORE(c0, c1) \
+	VADDPD  (C_PTR), c0, c0   \
+	VADDPD  32(C_PTR), c1, c1 \
+	VMOVUPD c0, (C_PTR)       \
+	VMOVUPD c1, 32(C_PTR)
+
+// func gemmKernelAVX2(k int, a, b, c []float64, ldc int)
+TEXT ·gemmKernelAVX2(SB), NOSPLIT, $0
+	MOVQ k+0(FP), K
+	MOVQ a_base+8(FP), A_PTR
+	MOVQ b_base+32(FP), B_PTR
+	MOVQ c_base+56(FP), C_PTR
+	MOVQ ldc+80(FP), LDC
+	SHLQ $3, LDC             // LDC *= sizeof(float64)
+
+	// The 4×8 block of C is accumulated four columns at a time in Y0-Y7.
+	VXORPD Y0, Y0, Y0
+	VXORPD Y1, Y1, Y1
+	VXORPD Y2, Y2, Y2
+	VXORPD Y3, Y3, Y3
+	VXORPD Y4, Y4, Y4
+	VXORPD Y5, Y5, Y5
+	VXORPD Y6, Y6, Y6
+	VXORPD Y7, Y7, Y7
+
+	CMPQ K, $0
+	JLE  gemm_store // if k <= 0 { goto gemm_store }
+
+gemm_loop: // do {
+	VMOVUPD (B_PTR), Y8   // Y8 = b[p*8:p*8+4]
+	VMOVUPD 32(B_PTR), Y9 // Y9 = b[p*8+4:p*8+8]
+	ROW(0, Y10, Y0, Y1)
+	ROW(8, Y11, Y2, Y3)
+	ROW(16, Y12, Y4, Y5)
+	ROW(24, Y13, Y6, Y7)
+
+	ADDQ $32, A_PTR // a = a[4:]
+	ADDQ $64, B_PTR // b = b[8:]
+	DECQ K
+	JNZ  gemm_loop  // } while --k > 0
+
+gemm_store:
+	STORE(Y0, Y1)
+	ADDQ LDC, C_PTR
+	STORE(Y2, Y3)
+	ADDQ LDC, C_PTR
+	STORE(Y4, Y5)
+	ADDQ LDC, C_PTR
+	STORE(Y6, Y7)
+	VZEROUPPER
+	RET
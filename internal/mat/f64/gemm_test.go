@@ -0,0 +1,87 @@
+package f64
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// gemmKernelGoBlock computes C += A * B for an mr×nr block of C packed for a
+// micro-kernel of that size, by repacking A and B into blocks of the size of
+// gemmKernelGo.
+func gemmKernelGoBlock(mr, nr, k int, a, b, c []float64, ldc int) {
+	ap := make([]float64, gemmGoMR*k)
+	bp := make([]float64, gemmGoNR*k)
+	var tile [gemmGoMR * gemmGoNR]float64
+	for ir := 0; ir < mr; ir += gemmGoMR {
+		rows := min(gemmGoMR, mr-ir)
+		clear(ap)
+		for p := 0; p < k; p++ {
+			copy(ap[p*gemmGoMR:p*gemmGoMR+rows], a[p*mr+ir:p*mr+ir+rows])
+		}
+		for jr := 0; jr < nr; jr += gemmGoNR {
+			cols := min(gemmGoNR, nr-jr)
+			clear(bp)
+			for p := 0; p < k; p++ {
+				copy(bp[p*gemmGoNR:p*gemmGoNR+cols], b[p*nr+jr:p*nr+jr+cols])
+			}
+			clear(tile[:])
+			gemmKernelGo(k, ap, bp, tile[:], gemmGoNR)
+			for i := 0; i < rows; i++ {
+				for j := 0; j < cols; j++ {
+					c[(ir+i)*ldc+jr+j] += tile[i*gemmGoNR+j]
+				}
+			}
+		}
+	}
+}
+
+// testGemmKernel checks that kernel, which updates an mr×nr block of C,
+// computes the same result as gemmKernelGo up to rounding, and that it does
+// not modify C outside the block.
+func testGemmKernel(t *testing.T, kernel func(k int, a, b, c []float64, ldc int), mr, nr int) {
+	const tol = 1e-14
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, k := range []int{0, 1, 2, 3, 7, 16, 255, 256, 1000} {
+		for _, ldc := range []int{nr, nr + 3} {
+			a := make([]float64, mr*k)
+			for i := range a {
+				a[i] = rnd.Float64()*2 - 1
+			}
+			b := make([]float64, nr*k)
+			for i := range b {
+				b[i] = rnd.Float64()*2 - 1
+			}
+			c := make([]float64, (mr-1)*ldc+nr)
+			for i := range c {
+				c[i] = rnd.Float64()*2 - 1
+			}
+			want := make([]float64, len(c))
+			copy(want, c)
+			gemmKernelGoBlock(mr, nr, k, a, b, want, ldc)
+
+			kernel(k, a, b, c, ldc)
+			for i := 0; i < mr; i++ {
+				for j := 0; j < ldc && i*ldc+j < len(c); j++ {
+					got, w := c[i*ldc+j], want[i*ldc+j]
+					if j >= nr {
+						if got != w {
+							t.Errorf("k=%d ldc=%d: C[%d,%d] outside the block modified", k, ldc, i, j)
+						}
+						continue
+					}
+					if math.Abs(got-w) > tol*float64(k+1) {
+						t.Errorf("k=%d ldc=%d: C[%d,%d] = %v, want %v", k, ldc, i, j, got, w)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestGemmKernel(t *testing.T) {
+	t.Run(fmt.Sprintf("%dx%d", GemmMR, GemmNR), func(t *testing.T) {
+		testGemmKernel(t, GemmKernel, GemmMR, GemmNR)
+	})
+}
@@ -31,4 +31,4 @@ func ScalInc(alpha float64, x []float64, n, incX uintptr)
 //		ix += incX
 //		idst += incDst
 //	}
-func ScalIncTo(dst []float64, incDst, idst uintptr, alpha float64, x []float64, n, incX, ix uintptr)
+func ScalIncTo(dst []float64, incDst uintptr, alpha float64, x []float64, n, incX uintptr)
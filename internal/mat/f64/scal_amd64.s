@@ -1,7 +1,5 @@
 #include "textflag.h"
 
-#define MOVDDUP_ALPHA    LONG $0x44120FF2; WORD $0x0824 // @ MOVDDUP XMM0, 8[RSP]
-
 #define X_PTR SI
 #define DST_PTR DI
 #define IDX AX
@@ -16,7 +14,7 @@
 
 // func ScalUnitary(alpha float64, x []float64)
 TEXT ·ScalUnitary(SB), NOSPLIT, $0
-	MOVDDUP_ALPHA            // ALPHA = { alpha, alpha }
+	MOVDDUP alpha+0(FP), ALPHA // ALPHA = { alpha, alpha }
 	MOVQ x_base+8(FP), X_PTR // X_PTR = &x
 	MOVQ x_len+16(FP), LEN   // LEN = len(x)
 	CMPQ LEN, $0
@@ -82,7 +80,7 @@ end:
 TEXT ·ScalUnitaryTo(SB), NOSPLIT, $0
 	MOVQ x_base+32(FP), X_PTR    // X_PTR = &x
 	MOVQ dst_base+0(FP), DST_PTR // DST_PTR = &dst
-	MOVDDUP_ALPHA                // ALPHA = { alpha, alpha }
+	MOVDDUP alpha+24(FP), ALPHA  // ALPHA = { alpha, alpha }
 	MOVQ x_len+40(FP), LEN       // LEN = len(x)
 	CMPQ LEN, $0
 	JE   end                     // if LEN == 0 { return }
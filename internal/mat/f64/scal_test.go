@@ -0,0 +1,112 @@
+package f64
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func scalIncToGo(dst []float64, incDst int, alpha float64, x []float64, n, incX int) {
+	var idst, ix int
+	for i := 0; i < n; i++ {
+		dst[idst] = alpha * x[ix]
+		ix += incX
+		idst += incDst
+	}
+}
+
+func TestScalUnitary(t *testing.T) {
+	const pad = 4
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range kernelLens {
+		for _, alpha := range []float64{0, 1, -2.5} {
+			xPad, x := randomVec(rnd, n, 1, pad)
+			want := slices.Clone(x)
+			scalIncToGo(want, 1, alpha, want, n, 1)
+
+			ScalUnitary(alpha, x)
+			name := fmt.Sprintf("n=%d,alpha=%v", n, alpha)
+			if !slices.Equal(x, want) {
+				t.Errorf("%s: got %v, want %v", name, x, want)
+			}
+			checkGuards(t, name, xPad, pad)
+		}
+	}
+}
+
+func TestScalUnitaryTo(t *testing.T) {
+	const pad = 4
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range kernelLens {
+		for _, alias := range []string{"none", "x"} {
+			for _, alpha := range []float64{0, 1, -2.5} {
+				_, x := randomVec(rnd, n, 1, pad)
+				dstPad, dst := randomVec(rnd, n, 1, pad)
+				if alias == "x" {
+					dstPad, dst = nil, x
+				}
+				want := make([]float64, n)
+				scalIncToGo(want, 1, alpha, x, n, 1)
+
+				ScalUnitaryTo(dst, alpha, x)
+				name := fmt.Sprintf("n=%d,alias=%s,alpha=%v", n, alias, alpha)
+				if !slices.Equal(dst, want) {
+					t.Errorf("%s: got %v, want %v", name, dst, want)
+				}
+				if dstPad != nil {
+					checkGuards(t, name, dstPad, pad)
+				}
+			}
+		}
+	}
+}
+
+func TestScalInc(t *testing.T) {
+	const pad = 4
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range kernelLens {
+		for _, inc := range []int{1, 2, 3, 7} {
+			const alpha = -2.5
+			xPad, x := randomVec(rnd, n, inc, pad)
+			want := slices.Clone(x)
+			scalIncToGo(want, inc, alpha, want, n, inc)
+
+			ScalInc(alpha, x, uintptr(n), uintptr(inc))
+			name := fmt.Sprintf("n=%d,inc=%d", n, inc)
+			if !slices.Equal(x, want) {
+				t.Errorf("%s: got %v, want %v", name, x, want)
+			}
+			checkGuards(t, name, xPad, pad)
+		}
+	}
+}
+
+func TestScalIncTo(t *testing.T) {
+	const pad = 4
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range kernelLens {
+		for _, incX := range []int{1, 2, 3, 7} {
+			for _, alias := range []string{"none", "x"} {
+				const alpha = -2.5
+				_, x := randomVec(rnd, n, incX, pad)
+				incDst := []int{1, 4}[n%2]
+				dstPad, dst := randomVec(rnd, n, incDst, pad)
+				if alias == "x" {
+					dstPad, dst, incDst = nil, x, incX
+				}
+				want := slices.Clone(dst)
+				scalIncToGo(want, incDst, alpha, slices.Clone(x), n, incX)
+
+				ScalIncTo(dst, uintptr(incDst), alpha, x, uintptr(n), uintptr(incX))
+				name := fmt.Sprintf("n=%d,incX=%d,alias=%s", n, incX, alias)
+				if !slices.Equal(dst, want) {
+					t.Errorf("%s: got %v, want %v", name, dst, want)
+				}
+				if dstPad != nil {
+					checkGuards(t, name, dstPad, pad)
+				}
+			}
+		}
+	}
+}
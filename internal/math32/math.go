@@ -4,6 +4,13 @@ import (
 	"math"
 )
 
+// Mathematical and limit constants.
+const (
+	Sqrt2      = math.Sqrt2
+	Ln2        = math.Ln2
+	MaxFloat32 = math.MaxFloat32
+)
+
 const (
 	unan    = 0x7fc00000
 	uinf    = 0x7f800000
@@ -101,6 +108,18 @@ func IsNaN(f float32) (is bool) {
 	return f != f
 }
 
+// Log returns the natural logarithm of x.
+//
+// Special cases are:
+//
+//	Log(+Inf) = +Inf
+//	Log(0) = -Inf
+//	Log(x < 0) = NaN
+//	Log(NaN) = NaN
+func Log(x float32) float32 {
+	return float32(math.Log(float64(x)))
+}
+
 // Max returns the larger of x or y.
 //
 // Special cases are:
@@ -156,3 +175,10 @@ func Min(x, y float32) float32 {
 
 // NaN returns an IEEE 754 “not-a-number” value.
 func NaN() float32 { return math.Float32frombits(unan) }
+
+// Pow returns x**y, the base-x exponential of y.
+//
+// Special cases are as for math.Pow.
+func Pow(x, y float32) float32 {
+	return float32(math.Pow(float64(x), float64(y)))
+}
@@ -16,6 +16,7 @@ const (
 	// Dimension errors
 	ErrMLT0  = "lapack: m < 0"
 	ErrNLT0  = "lapack: n < 0"
+	ErrNLT1  = "lapack: n < 1"
 	ErrKLT0  = "lapack: k < 0"
 	ErrKLT1  = "lapack: k < 1"
 	ErrKdLT0 = "lapack: kd < 0"
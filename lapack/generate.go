@@ -1,3 +1,109 @@
 //go:build ignore
 
 package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Replacement rules for converting lapack64 to lapack32
+var replacements = []struct {
+	pattern string
+	replace string
+	isRegex bool
+}{
+	// Package name (must be first)
+	{"package lapack64", "package lapack32", false},
+
+	// Import replacements (before other replacements)
+	{`"github.com/gocnn/gomat/blas/blas64"`, `"github.com/gocnn/gomat/blas/blas32"`, false},
+	{`"github.com/gocnn/gomat/internal/mat/f64"`, `"github.com/gocnn/gomat/internal/mat/f32"`, false},
+	{`"math"`, `math "github.com/gocnn/gomat/internal/math32"`, false},
+
+	// Interfaces
+	{"lapack.Float64", "lapack.Float32", false},
+
+	// BLAS function calls
+	{`\bblas64\.`, "blas32.", true},
+
+	// All f64 to f32 internal function calls
+	{"f64.L1Norm", "f32.L1Norm", false},
+
+	// Constants
+	{"math.MaxFloat64", "math.MaxFloat32", false},
+
+	// Untyped floating point literals in short variable declarations
+	// default to float64.
+	{`:= (-?[0-9]+)\.0\b`, ":= float32($1)", true},
+	{`:= (-?[0-9]+\.[0-9]+)\b`, ":= float32($1)", true},
+
+	// Type replacements (must be last to avoid conflicts)
+	{"float64", "float32", false},
+}
+
+// Files that hold precision specific definitions and are maintained by hand
+// in both packages.
+var skip = map[string]bool{
+	"lapack64.go": true,
+}
+
+func main() {
+	// Source and destination directories
+	srcDir := "lapack64"
+	dstDir := "lapack32"
+
+	// Create destination directory if it doesn't exist
+	if err := os.MkdirAll(dstDir, 0755); err != nil {
+		fmt.Printf("Error creating directory %s: %v\n", dstDir, err)
+		return
+	}
+
+	files, err := filepath.Glob(filepath.Join(srcDir, "*.go"))
+	if err != nil {
+		fmt.Printf("Error listing %s: %v\n", srcDir, err)
+		return
+	}
+
+	for _, srcPath := range files {
+		file := filepath.Base(srcPath)
+		if skip[file] || strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		dstPath := filepath.Join(dstDir, file)
+
+		fmt.Printf("Generating %s from %s\n", dstPath, srcPath)
+
+		// Read source file
+		content, err := os.ReadFile(srcPath)
+		if err != nil {
+			fmt.Printf("Error reading %s: %v\n", srcPath, err)
+			continue
+		}
+
+		// Apply replacements in order
+		result := string(content)
+		for _, repl := range replacements {
+			if repl.isRegex {
+				// Use regex for patterns with special regex syntax
+				re := regexp.MustCompile(repl.pattern)
+				result = re.ReplaceAllString(result, repl.replace)
+			} else {
+				// Simple string replacement
+				result = strings.ReplaceAll(result, repl.pattern, repl.replace)
+			}
+		}
+
+		// Write destination file
+		err = os.WriteFile(dstPath, []byte(result), 0644)
+		if err != nil {
+			fmt.Printf("Error writing %s: %v\n", dstPath, err)
+			continue
+		}
+
+		fmt.Printf("Successfully generated %s\n", dstPath)
+	}
+}
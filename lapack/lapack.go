@@ -1,5 +1,7 @@
 package lapack
 
+//go:generate go run generate.go
+
 import "github.com/gocnn/gomat/blas"
 
 // Direct specifies the direction of the multiplication for the Householder matrix.
@@ -268,3 +270,15 @@ type Float64 interface {
 	Trtri(uplo blas.Uplo, diag blas.Diag, n int, a []float64, lda int) (ok bool)
 	Trtrs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, n, nrhs int, a []float64, lda int, b []float64, ldb int) (ok bool)
 }
+
+// Complex128 defines the public complex128 LAPACK API supported by gonum/lapack.
+type Complex128 interface {
+	Geqrf(m, n int, a []complex128, lda int, tau, work []complex128, lwork int)
+	Getrf(m, n int, a []complex128, lda int, ipiv []int) (ok bool)
+	Getrs(trans blas.Transpose, n, nrhs int, a []complex128, lda int, ipiv []int, b []complex128, ldb int)
+	Heev(jobz EVJob, uplo blas.Uplo, n int, a []complex128, lda int, w []float64, work []complex128, lwork int, rwork []float64) (ok bool)
+	Potrf(ul blas.Uplo, n int, a []complex128, lda int) (ok bool)
+	Potrs(ul blas.Uplo, n, nrhs int, a []complex128, lda int, b []complex128, ldb int)
+	Ungqr(m, n, k int, a []complex128, lda int, tau, work []complex128, lwork int)
+	Unmqr(side blas.Side, trans blas.Transpose, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int)
+}
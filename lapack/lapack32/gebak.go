@@ -0,0 +1,89 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"github.com/gocnn/gomat/blas/blas32"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Gebak updates an n×m matrix V as
+//
+//	V = P D V       if side == lapack.EVRight,
+//	V = P D^{-1} V  if side == lapack.EVLeft,
+//
+// where P and D are n×n permutation and scaling matrices, respectively,
+// implicitly represented by job, scale, ilo and ihi as returned by Gebal.
+//
+// Typically, columns of the matrix V contain the right or left (determined by
+// side) eigenvectors of the balanced matrix output by Gebal, and Gebak forms
+// the eigenvectors of the original matrix.
+//
+// Gebak is an internal routine.
+func Gebak(job lapack.BalanceJob, side lapack.EVSide, n, ilo, ihi int, scale []float32, m int, v []float32, ldv int) {
+	switch {
+	case job != lapack.BalanceNone && job != lapack.Permute && job != lapack.Scale && job != lapack.PermuteScale:
+		panic(lapack.ErrBadBalanceJob)
+	case side != lapack.EVLeft && side != lapack.EVRight:
+		panic(lapack.ErrBadEVSide)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case ilo < 0 || max(0, n-1) < ilo:
+		panic(lapack.ErrIloRange)
+	case ihi < min(ilo, n-1) || n <= ihi:
+		panic(lapack.ErrIhiRange)
+	case m < 0:
+		panic(lapack.ErrMLT0)
+	case ldv < max(1, m):
+		panic(lapack.ErrBadLdV)
+	}
+
+	// Quick return if possible.
+	if n == 0 || m == 0 {
+		return
+	}
+
+	if len(scale) < n {
+		panic(lapack.ErrShortScale)
+	}
+	if len(v) < (n-1)*ldv+m {
+		panic(lapack.ErrShortV)
+	}
+
+	// Quick return if possible.
+	if job == lapack.BalanceNone {
+		return
+	}
+	if ilo != ihi && job != lapack.Permute {
+		// Backward balance.
+		if side == lapack.EVRight {
+			for i := ilo; i <= ihi; i++ {
+				blas32.Scal(m, scale[i], v[i*ldv:], 1)
+			}
+		} else {
+			for i := ilo; i <= ihi; i++ {
+				blas32.Scal(m, 1/scale[i], v[i*ldv:], 1)
+			}
+		}
+	}
+	if job == lapack.Scale {
+		return
+	}
+	// Backward permutation.
+	for i := ilo - 1; i >= 0; i-- {
+		k := int(scale[i])
+		if k == i {
+			continue
+		}
+		blas32.Swap(m, v[i*ldv:], 1, v[k*ldv:], 1)
+	}
+	for i := ihi + 1; i < n; i++ {
+		k := int(scale[i])
+		if k == i {
+			continue
+		}
+		blas32.Swap(m, v[i*ldv:], 1, v[k*ldv:], 1)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	math "github.com/gocnn/gomat/internal/math32"
+
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/blas32"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Gecon estimates and returns the reciprocal of the condition number of the
+// n×n matrix A, in either the 1-norm or the ∞-norm, using the LU factorization
+// computed by Getrf.
+//
+// An estimate is obtained for norm(A⁻¹), and the reciprocal of the condition
+// number rcond is computed as
+//
+//	rcond 1 / ( norm(A) * norm(A⁻¹) ).
+//
+// If n is zero, rcond is always 1.
+//
+// anorm is the 1-norm or the ∞-norm of the original matrix A. anorm must be
+// non-negative, otherwise Gecon will panic. If anorm is 0 or infinity, Gecon
+// returns 0. If anorm is NaN, Gecon returns NaN.
+//
+// work must have length at least 4*n and iwork must have length at least n,
+// otherwise Gecon will panic.
+func Gecon(norm lapack.MatrixNorm, n int, a []float32, lda int, anorm float32, work []float32, iwork []int) float32 {
+	switch {
+	case norm != lapack.MaxColumnSum && norm != lapack.MaxRowSum:
+		panic(lapack.ErrBadNorm)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	case anorm < 0:
+		panic(lapack.ErrNegANorm)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(lapack.ErrShortA)
+	case len(work) < 4*n:
+		panic(lapack.ErrShortWork)
+	case len(iwork) < n:
+		panic(lapack.ErrShortIWork)
+	}
+
+	// Quick return if possible.
+	switch {
+	case anorm == 0:
+		return 0
+	case math.IsNaN(anorm):
+		// Propagate NaN.
+		return anorm
+	case math.IsInf(anorm, 1):
+		return 0
+	}
+	var rcond, ainvnm float32
+	var kase int
+	var normin bool
+	isave := new([3]int)
+	onenrm := norm == lapack.MaxColumnSum
+	smlnum := lamchS
+	kase1 := 2
+	if onenrm {
+		kase1 = 1
+	}
+	for {
+		ainvnm, kase = Lacn2(n, work[n:], work, iwork, ainvnm, kase, isave)
+		if kase == 0 {
+			if ainvnm != 0 {
+				rcond = (1 / ainvnm) / anorm
+			}
+			return rcond
+		}
+		var sl, su float32
+		if kase == kase1 {
+			sl = Latrs(blas.Lower, blas.NoTrans, blas.Unit, normin, n, a, lda, work, work[2*n:])
+			su = Latrs(blas.Upper, blas.NoTrans, blas.NonUnit, normin, n, a, lda, work, work[3*n:])
+		} else {
+			su = Latrs(blas.Upper, blas.Trans, blas.NonUnit, normin, n, a, lda, work, work[3*n:])
+			sl = Latrs(blas.Lower, blas.Trans, blas.Unit, normin, n, a, lda, work, work[2*n:])
+		}
+		scale := sl * su
+		normin = true
+		if scale != 1 {
+			ix := blas32.Iamax(n, work, 1)
+			if scale == 0 || scale < math.Abs(work[ix])*smlnum {
+				return rcond
+			}
+			Rscl(n, scale, work, 1)
+		}
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	math "github.com/gocnn/gomat/internal/math32"
+
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/blas32"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Geev computes the eigenvalues and, optionally, the left and/or right
+// eigenvectors for an n×n real nonsymmetric matrix A.
+//
+// The right eigenvector v_j of A corresponding to an eigenvalue λ_j
+// is defined by
+//
+//	A v_j = λ_j v_j,
+//
+// and the left eigenvector u_j corresponding to an eigenvalue λ_j is defined by
+//
+//	u_jᴴ A = λ_j u_jᴴ,
+//
+// where u_jᴴ is the conjugate transpose of u_j.
+//
+// On return, A will be overwritten and the left and right eigenvectors will be
+// stored, respectively, in the columns of the n×n matrices VL and VR in the
+// same order as their eigenvalues. If the j-th eigenvalue is real, then
+//
+//	u_j = VL[:,j],
+//	v_j = VR[:,j],
+//
+// and if it is not real, then j and j+1 form a complex conjugate pair and the
+// eigenvectors can be recovered as
+//
+//	u_j     = VL[:,j] + i*VL[:,j+1],
+//	u_{j+1} = VL[:,j] - i*VL[:,j+1],
+//	v_j     = VR[:,j] + i*VR[:,j+1],
+//	v_{j+1} = VR[:,j] - i*VR[:,j+1],
+//
+// where i is the imaginary unit. The computed eigenvectors are normalized to
+// have Euclidean norm equal to 1 and largest component real.
+//
+// Left eigenvectors will be computed only if jobvl == lapack.LeftEVCompute,
+// otherwise jobvl must be lapack.LeftEVNone.
+// Right eigenvectors will be computed only if jobvr == lapack.RightEVCompute,
+// otherwise jobvr must be lapack.RightEVNone.
+// For other values of jobvl and jobvr Geev will panic.
+//
+// wr and wi contain the real and imaginary parts, respectively, of the computed
+// eigenvalues. Complex conjugate pairs of eigenvalues appear consecutively with
+// the eigenvalue having the positive imaginary part first.
+// wr and wi must have length n, and Geev will panic otherwise.
+//
+// work must have length at least lwork and lwork must be at least max(1,4*n) if
+// the left or right eigenvectors are computed, and at least max(1,3*n) if no
+// eigenvectors are computed. For good performance, lwork must generally be
+// larger.  On return, optimal value of lwork will be stored in work[0].
+//
+// If lwork == -1, instead of performing Geev, the function only calculates the
+// optimal value of lwork and stores it into work[0].
+//
+// On return, first is the index of the first valid eigenvalue. If first == 0,
+// all eigenvalues and eigenvectors have been computed. If first is positive,
+// Geev failed to compute all the eigenvalues, no eigenvectors have been
+// computed and wr[first:] and wi[first:] contain those eigenvalues which have
+// converged.
+func Geev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []float32, lda int, wr, wi []float32, vl []float32, ldvl int, vr []float32, ldvr int, work []float32, lwork int) (first int) {
+	wantvl := jobvl == lapack.LeftEVCompute
+	wantvr := jobvr == lapack.RightEVCompute
+	var minwrk int
+	if wantvl || wantvr {
+		minwrk = max(1, 4*n)
+	} else {
+		minwrk = max(1, 3*n)
+	}
+	switch {
+	case jobvl != lapack.LeftEVCompute && jobvl != lapack.LeftEVNone:
+		panic(lapack.ErrBadLeftEVJob)
+	case jobvr != lapack.RightEVCompute && jobvr != lapack.RightEVNone:
+		panic(lapack.ErrBadRightEVJob)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	case ldvl < 1 || (ldvl < n && wantvl):
+		panic(lapack.ErrBadLdVL)
+	case ldvr < 1 || (ldvr < n && wantvr):
+		panic(lapack.ErrBadLdVR)
+	case lwork < minwrk && lwork != -1:
+		panic(lapack.ErrBadLWork)
+	case len(work) < lwork:
+		panic(lapack.ErrShortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return 0
+	}
+
+	maxwrk := 2*n + n*Ilaenv(1, "DGEHRD", " ", n, 1, n, 0)
+	if wantvl || wantvr {
+		maxwrk = max(maxwrk, 2*n+(n-1)*Ilaenv(1, "DORGHR", " ", n, 1, n, -1))
+		Hseqr(lapack.EigenvaluesAndSchur, lapack.SchurOrig, n, 0, n-1,
+			a, lda, wr, wi, nil, n, work, -1)
+		maxwrk = max(maxwrk, max(n+1, n+int(work[0])))
+		side := lapack.EVLeft
+		if wantvr {
+			side = lapack.EVRight
+		}
+		Trevc3(side, lapack.EVAllMulQ, nil, n, a, lda, vl, ldvl, vr, ldvr,
+			n, work, -1)
+		maxwrk = max(maxwrk, n+int(work[0]))
+		maxwrk = max(maxwrk, 4*n)
+	} else {
+		Hseqr(lapack.EigenvaluesOnly, lapack.SchurNone, n, 0, n-1,
+			a, lda, wr, wi, vr, ldvr, work, -1)
+		maxwrk = max(maxwrk, max(n+1, n+int(work[0])))
+	}
+	maxwrk = max(maxwrk, minwrk)
+
+	if lwork == -1 {
+		work[0] = float32(maxwrk)
+		return 0
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(lapack.ErrShortA)
+	case len(wr) != n:
+		panic(lapack.ErrBadLenWr)
+	case len(wi) != n:
+		panic(lapack.ErrBadLenWi)
+	case len(vl) < (n-1)*ldvl+n && wantvl:
+		panic(lapack.ErrShortVL)
+	case len(vr) < (n-1)*ldvr+n && wantvr:
+		panic(lapack.ErrShortVR)
+	}
+
+	// Get machine constants.
+	smlnum := math.Sqrt(lamchS) / lamchP
+	bignum := 1 / smlnum
+
+	// Scale A if max element outside range [smlnum,bignum].
+	anrm := Lange(lapack.MaxAbs, n, n, a, lda, nil)
+	var scalea bool
+	var cscale float32
+	if 0 < anrm && anrm < smlnum {
+		scalea = true
+		cscale = smlnum
+	} else if anrm > bignum {
+		scalea = true
+		cscale = bignum
+	}
+	if scalea {
+		Lascl(lapack.General, 0, 0, anrm, cscale, n, n, a, lda)
+	}
+
+	// Balance the matrix.
+	workbal := work[:n]
+	ilo, ihi := Gebal(lapack.PermuteScale, n, a, lda, workbal)
+
+	// Reduce to upper Hessenberg form.
+	iwrk := 2 * n
+	tau := work[n : iwrk-1]
+	Gehrd(n, ilo, ihi, a, lda, tau, work[iwrk:], lwork-iwrk)
+
+	var side lapack.EVSide
+	if wantvl {
+		side = lapack.EVLeft
+		// Copy Householder vectors to VL.
+		Lacpy(blas.Lower, n, n, a, lda, vl, ldvl)
+		// Generate orthogonal matrix in VL.
+		Orghr(n, ilo, ihi, vl, ldvl, tau, work[iwrk:], lwork-iwrk)
+		// Perform QR iteration, accumulating Schur vectors in VL.
+		iwrk = n
+		first = Hseqr(lapack.EigenvaluesAndSchur, lapack.SchurOrig, n, ilo, ihi,
+			a, lda, wr, wi, vl, ldvl, work[iwrk:], lwork-iwrk)
+		if wantvr {
+			// Want left and right eigenvectors.
+			// Copy Schur vectors to VR.
+			side = lapack.EVBoth
+			Lacpy(blas.All, n, n, vl, ldvl, vr, ldvr)
+		}
+	} else if wantvr {
+		side = lapack.EVRight
+		// Copy Householder vectors to VR.
+		Lacpy(blas.Lower, n, n, a, lda, vr, ldvr)
+		// Generate orthogonal matrix in VR.
+		Orghr(n, ilo, ihi, vr, ldvr, tau, work[iwrk:], lwork-iwrk)
+		// Perform QR iteration, accumulating Schur vectors in VR.
+		iwrk = n
+		first = Hseqr(lapack.EigenvaluesAndSchur, lapack.SchurOrig, n, ilo, ihi,
+			a, lda, wr, wi, vr, ldvr, work[iwrk:], lwork-iwrk)
+	} else {
+		// Compute eigenvalues only.
+		iwrk = n
+		first = Hseqr(lapack.EigenvaluesOnly, lapack.SchurNone, n, ilo, ihi,
+			a, lda, wr, wi, nil, 1, work[iwrk:], lwork-iwrk)
+	}
+
+	if first > 0 {
+		if scalea {
+			// Undo scaling.
+			Lascl(lapack.General, 0, 0, cscale, anrm, n-first, 1, wr[first:], 1)
+			Lascl(lapack.General, 0, 0, cscale, anrm, n-first, 1, wi[first:], 1)
+			Lascl(lapack.General, 0, 0, cscale, anrm, ilo, 1, wr, 1)
+			Lascl(lapack.General, 0, 0, cscale, anrm, ilo, 1, wi, 1)
+		}
+		work[0] = float32(maxwrk)
+		return first
+	}
+
+	if wantvl || wantvr {
+		// Compute left and/or right eigenvectors.
+		Trevc3(side, lapack.EVAllMulQ, nil, n,
+			a, lda, vl, ldvl, vr, ldvr, n, work[iwrk:], lwork-iwrk)
+	}
+	if wantvl {
+		// Undo balancing of left eigenvectors.
+		Gebak(lapack.PermuteScale, lapack.EVLeft, n, ilo, ihi, workbal, n, vl, ldvl)
+		// Normalize left eigenvectors and make largest component real.
+		for i, wii := range wi {
+			if wii < 0 {
+				continue
+			}
+			if wii == 0 {
+				scl := 1 / blas32.Nrm2(n, vl[i:], ldvl)
+				blas32.Scal(n, scl, vl[i:], ldvl)
+				continue
+			}
+			scl := 1 / Lapy2(blas32.Nrm2(n, vl[i:], ldvl), blas32.Nrm2(n, vl[i+1:], ldvl))
+			blas32.Scal(n, scl, vl[i:], ldvl)
+			blas32.Scal(n, scl, vl[i+1:], ldvl)
+			for k := 0; k < n; k++ {
+				vi := vl[k*ldvl+i]
+				vi1 := vl[k*ldvl+i+1]
+				work[iwrk+k] = vi*vi + vi1*vi1
+			}
+			k := blas32.Iamax(n, work[iwrk:iwrk+n], 1)
+			cs, sn, _ := Lartg(vl[k*ldvl+i], vl[k*ldvl+i+1])
+			blas32.Rot(n, vl[i:], ldvl, vl[i+1:], ldvl, cs, sn)
+			vl[k*ldvl+i+1] = 0
+		}
+	}
+	if wantvr {
+		// Undo balancing of right eigenvectors.
+		Gebak(lapack.PermuteScale, lapack.EVRight, n, ilo, ihi, workbal, n, vr, ldvr)
+		// Normalize right eigenvectors and make largest component real.
+		for i, wii := range wi {
+			if wii < 0 {
+				continue
+			}
+			if wii == 0 {
+				scl := 1 / blas32.Nrm2(n, vr[i:], ldvr)
+				blas32.Scal(n, scl, vr[i:], ldvr)
+				continue
+			}
+			scl := 1 / Lapy2(blas32.Nrm2(n, vr[i:], ldvr), blas32.Nrm2(n, vr[i+1:], ldvr))
+			blas32.Scal(n, scl, vr[i:], ldvr)
+			blas32.Scal(n, scl, vr[i+1:], ldvr)
+			for k := 0; k < n; k++ {
+				vi := vr[k*ldvr+i]
+				vi1 := vr[k*ldvr+i+1]
+				work[iwrk+k] = vi*vi + vi1*vi1
+			}
+			k := blas32.Iamax(n, work[iwrk:iwrk+n], 1)
+			cs, sn, _ := Lartg(vr[k*ldvr+i], vr[k*ldvr+i+1])
+			blas32.Rot(n, vr[i:], ldvr, vr[i+1:], ldvr, cs, sn)
+			vr[k*ldvr+i+1] = 0
+		}
+	}
+
+	if scalea {
+		// Undo scaling.
+		Lascl(lapack.General, 0, 0, cscale, anrm, n-first, 1, wr[first:], 1)
+		Lascl(lapack.General, 0, 0, cscale, anrm, n-first, 1, wi[first:], 1)
+	}
+
+	work[0] = float32(maxwrk)
+	return first
+}
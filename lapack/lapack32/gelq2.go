@@ -0,0 +1,68 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Gelq2 computes the LQ factorization of the m×n matrix A.
+//
+// In an LQ factorization, L is a lower triangular m×n matrix, and Q is an n×n
+// orthonormal matrix.
+//
+// a is modified to contain the information to construct L and Q.
+// The lower triangle of a contains the matrix L. The upper triangular elements
+// (not including the diagonal) contain the elementary reflectors. tau is modified
+// to contain the reflector scales. tau must have length of at least k = min(m,n)
+// and this function will panic otherwise.
+//
+// See Geqr2 for a description of the elementary reflectors and orthonormal
+// matrix Q. Q is constructed as a product of these elementary reflectors,
+// Q = H_{k-1} * ... * H_1 * H_0.
+//
+// work is temporary storage of length at least m and this function will panic otherwise.
+//
+// Gelq2 is an internal routine.
+func Gelq2(m, n int, a []float32, lda int, tau, work []float32) {
+	switch {
+	case m < 0:
+		panic(lapack.ErrMLT0)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	}
+
+	// Quick return if possible.
+	k := min(m, n)
+	if k == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(lapack.ErrShortA)
+	case len(tau) < k:
+		panic(lapack.ErrShortTau)
+	case len(work) < m:
+		panic(lapack.ErrShortWork)
+	}
+
+	for i := 0; i < k; i++ {
+		a[i*lda+i], tau[i] = Larfg(n-i, a[i*lda+i], a[i*lda+min(i+1, n-1):], 1)
+		if i < m-1 {
+			aii := a[i*lda+i]
+			a[i*lda+i] = 1
+			Larf(blas.Right, m-i-1, n-i,
+				a[i*lda+i:], 1,
+				tau[i],
+				a[(i+1)*lda+i:], lda,
+				work)
+			a[i*lda+i] = aii
+		}
+	}
+}
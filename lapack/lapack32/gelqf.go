@@ -0,0 +1,97 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Gelqf computes the LQ factorization of the m×n matrix A using a blocked
+// algorithm. See the documentation for Gelq2 for a description of the
+// parameters at entry and exit.
+//
+// work is temporary storage, and lwork specifies the usable memory length.
+// At minimum, lwork >= m, and this function will panic otherwise.
+// Gelqf is a blocked LQ factorization, but the block size is limited
+// by the temporary space available. If lwork == -1, instead of performing Gelqf,
+// the optimal work length will be stored into work[0].
+//
+// tau must have length at least min(m,n), and this function will panic otherwise.
+func Gelqf(m, n int, a []float32, lda int, tau, work []float32, lwork int) {
+	switch {
+	case m < 0:
+		panic(lapack.ErrMLT0)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	case lwork < max(1, m) && lwork != -1:
+		panic(lapack.ErrBadLWork)
+	case len(work) < max(1, lwork):
+		panic(lapack.ErrShortWork)
+	}
+
+	k := min(m, n)
+	if k == 0 {
+		work[0] = 1
+		return
+	}
+
+	nb := Ilaenv(1, "DGELQF", " ", m, n, -1, -1)
+	if lwork == -1 {
+		work[0] = float32(m * nb)
+		return
+	}
+
+	if len(a) < (m-1)*lda+n {
+		panic(lapack.ErrShortA)
+	}
+	if len(tau) < k {
+		panic(lapack.ErrShortTau)
+	}
+
+	// Find the optimal blocking size based on the size of available memory
+	// and optimal machine parameters.
+	nbmin := 2
+	var nx int
+	iws := m
+	if 1 < nb && nb < k {
+		nx = max(0, Ilaenv(3, "DGELQF", " ", m, n, -1, -1))
+		if nx < k {
+			iws = m * nb
+			if lwork < iws {
+				nb = lwork / m
+				nbmin = max(2, Ilaenv(2, "DGELQF", " ", m, n, -1, -1))
+			}
+		}
+	}
+	ldwork := nb
+	// Computed blocked LQ factorization.
+	var i int
+	if nbmin <= nb && nb < k && nx < k {
+		for i = 0; i < k-nx; i += nb {
+			ib := min(k-i, nb)
+			Gelq2(ib, n-i, a[i*lda+i:], lda, tau[i:], work)
+			if i+ib < m {
+				Larft(lapack.Forward, lapack.RowWise, n-i, ib,
+					a[i*lda+i:], lda,
+					tau[i:],
+					work, ldwork)
+				Larfb(blas.Right, blas.NoTrans, lapack.Forward, lapack.RowWise,
+					m-i-ib, n-i, ib,
+					a[i*lda+i:], lda,
+					work, ldwork,
+					a[(i+ib)*lda+i:], lda,
+					work[ib*ldwork:], ldwork)
+			}
+		}
+	}
+	// Perform unblocked LQ factorization on the remainder.
+	if i < k {
+		Gelq2(m-i, n-i, a[i*lda+i:], lda, tau[i:], work)
+	}
+	work[0] = float32(iws)
+}
@@ -0,0 +1,220 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Gels finds a minimum-norm solution based on the matrices A and B using the
+// QR or LQ factorization. Gels returns false if the matrix
+// A is singular, and true if this solution was successfully found.
+//
+// The minimization problem solved depends on the input parameters.
+//
+//  1. If m >= n and trans == blas.NoTrans, Gels finds X such that || A*X - B||_2
+//     is minimized.
+//  2. If m < n and trans == blas.NoTrans, Gels finds the minimum norm solution of
+//     A * X = B.
+//  3. If m >= n and trans == blas.Trans, Gels finds the minimum norm solution of
+//     Aᵀ * X = B.
+//  4. If m < n and trans == blas.Trans, Gels finds X such that || Aᵀ*X - B||_2
+//     is minimized.
+//
+// Note that the least-squares solutions (cases 1 and 3) perform the minimization
+// per column of B. This is not the same as finding the minimum-norm matrix.
+//
+// The matrix A is a general matrix of size m×n and is modified during this call.
+// The input matrix B is of size max(m,n)×nrhs, and serves two purposes. On entry,
+// the elements of b specify the input matrix B. B has size m×nrhs if
+// trans == blas.NoTrans, and n×nrhs if trans == blas.Trans. On exit, the
+// leading submatrix of b contains the solution vectors X. If trans == blas.NoTrans,
+// this submatrix is of size n×nrhs, and of size m×nrhs otherwise.
+//
+// work is temporary storage, and lwork specifies the usable memory length.
+// At minimum, lwork >= min(m,n) + max(min(m,n),nrhs), and this function will panic
+// otherwise. A longer work will enable blocked algorithms to be called.
+// In the special case that lwork == -1, work[0] will be set to the optimal working
+// length.
+func Gels(trans blas.Transpose, m, n, nrhs int, a []float32, lda int, b []float32, ldb int, work []float32, lwork int) bool {
+	mn := min(m, n)
+	minwrk := mn + max(mn, nrhs)
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(lapack.ErrBadTrans)
+	case m < 0:
+		panic(lapack.ErrMLT0)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case nrhs < 0:
+		panic(lapack.ErrNrhsLT0)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	case ldb < max(1, nrhs):
+		panic(lapack.ErrBadLdB)
+	case lwork < max(1, minwrk) && lwork != -1:
+		panic(lapack.ErrBadLWork)
+	case len(work) < max(1, lwork):
+		panic(lapack.ErrShortWork)
+	}
+
+	// Quick return if possible.
+	if mn == 0 || nrhs == 0 {
+		Laset(blas.All, max(m, n), nrhs, 0, 0, b, ldb)
+		work[0] = 1
+		return true
+	}
+
+	// Find optimal block size.
+	var nb int
+	if m >= n {
+		nb = Ilaenv(1, "DGEQRF", " ", m, n, -1, -1)
+		if trans != blas.NoTrans {
+			nb = max(nb, Ilaenv(1, "DORMQR", "LN", m, nrhs, n, -1))
+		} else {
+			nb = max(nb, Ilaenv(1, "DORMQR", "LT", m, nrhs, n, -1))
+		}
+	} else {
+		nb = Ilaenv(1, "DGELQF", " ", m, n, -1, -1)
+		if trans != blas.NoTrans {
+			nb = max(nb, Ilaenv(1, "DORMLQ", "LT", n, nrhs, m, -1))
+		} else {
+			nb = max(nb, Ilaenv(1, "DORMLQ", "LN", n, nrhs, m, -1))
+		}
+	}
+	wsize := max(1, mn+max(mn, nrhs)*nb)
+	work[0] = float32(wsize)
+
+	if lwork == -1 {
+		return true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(lapack.ErrShortA)
+	case len(b) < (max(m, n)-1)*ldb+nrhs:
+		panic(lapack.ErrShortB)
+	}
+
+	// Scale the input matrices if they contain extreme values.
+	smlnum := lamchS / lamchP
+	bignum := 1 / smlnum
+	anrm := Lange(lapack.MaxAbs, m, n, a, lda, nil)
+	var iascl int
+	if anrm > 0 && anrm < smlnum {
+		Lascl(lapack.General, 0, 0, anrm, smlnum, m, n, a, lda)
+		iascl = 1
+	} else if anrm > bignum {
+		Lascl(lapack.General, 0, 0, anrm, bignum, m, n, a, lda)
+	} else if anrm == 0 {
+		// Matrix is all zeros.
+		Laset(blas.All, max(m, n), nrhs, 0, 0, b, ldb)
+		return true
+	}
+	brow := m
+	if trans != blas.NoTrans {
+		brow = n
+	}
+	bnrm := Lange(lapack.MaxAbs, brow, nrhs, b, ldb, nil)
+	ibscl := 0
+	if bnrm > 0 && bnrm < smlnum {
+		Lascl(lapack.General, 0, 0, bnrm, smlnum, brow, nrhs, b, ldb)
+		ibscl = 1
+	} else if bnrm > bignum {
+		Lascl(lapack.General, 0, 0, bnrm, bignum, brow, nrhs, b, ldb)
+		ibscl = 2
+	}
+
+	// Solve the minimization problem using a QR or an LQ decomposition.
+	var scllen int
+	if m >= n {
+		Geqrf(m, n, a, lda, work[:n], work[mn:], lwork-mn)
+		if trans == blas.NoTrans {
+			Ormqr(blas.Left, blas.Trans, m, nrhs, n,
+				a, lda,
+				work[:n],
+				b, ldb,
+				work[mn:], lwork-mn)
+			ok := Trtrs(blas.Upper, blas.NoTrans, blas.NonUnit, n, nrhs,
+				a, lda,
+				b, ldb)
+			if !ok {
+				return false
+			}
+			scllen = n
+		} else {
+			ok := Trtrs(blas.Upper, blas.Trans, blas.NonUnit, n, nrhs,
+				a, lda,
+				b, ldb)
+			if !ok {
+				return false
+			}
+			for i := n; i < m; i++ {
+				for j := 0; j < nrhs; j++ {
+					b[i*ldb+j] = 0
+				}
+			}
+			Ormqr(blas.Left, blas.NoTrans, m, nrhs, n,
+				a, lda,
+				work[:n],
+				b, ldb,
+				work[mn:], lwork-mn)
+			scllen = m
+		}
+	} else {
+		Gelqf(m, n, a, lda, work, work[mn:], lwork-mn)
+		if trans == blas.NoTrans {
+			ok := Trtrs(blas.Lower, blas.NoTrans, blas.NonUnit,
+				m, nrhs,
+				a, lda,
+				b, ldb)
+			if !ok {
+				return false
+			}
+			for i := m; i < n; i++ {
+				for j := 0; j < nrhs; j++ {
+					b[i*ldb+j] = 0
+				}
+			}
+			Ormlq(blas.Left, blas.Trans, n, nrhs, m,
+				a, lda,
+				work,
+				b, ldb,
+				work[mn:], lwork-mn)
+			scllen = n
+		} else {
+			Ormlq(blas.Left, blas.NoTrans, n, nrhs, m,
+				a, lda,
+				work,
+				b, ldb,
+				work[mn:], lwork-mn)
+			ok := Trtrs(blas.Lower, blas.Trans, blas.NonUnit,
+				m, nrhs,
+				a, lda,
+				b, ldb)
+			if !ok {
+				return false
+			}
+		}
+	}
+
+	// Adjust answer vector based on scaling.
+	if iascl == 1 {
+		Lascl(lapack.General, 0, 0, anrm, smlnum, scllen, nrhs, b, ldb)
+	}
+	if iascl == 2 {
+		Lascl(lapack.General, 0, 0, anrm, bignum, scllen, nrhs, b, ldb)
+	}
+	if ibscl == 1 {
+		Lascl(lapack.General, 0, 0, smlnum, bnrm, scllen, nrhs, b, ldb)
+	}
+	if ibscl == 2 {
+		Lascl(lapack.General, 0, 0, bignum, bnrm, scllen, nrhs, b, ldb)
+	}
+
+	work[0] = float32(wsize)
+	return true
+}
@@ -0,0 +1,114 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/blas32"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Getri computes the inverse of the matrix A using the LU factorization computed
+// by Getrf. On entry, a contains the PLU decomposition of A as computed by
+// Getrf and on exit contains the reciprocal of the original matrix.
+//
+// Getri will not perform the inversion if the matrix is singular, and returns
+// a boolean indicating whether the inversion was successful.
+//
+// work is temporary storage, and lwork specifies the usable memory length.
+// At minimum, lwork >= n and this function will panic otherwise.
+// Getri is a blocked inversion, but the block size is limited
+// by the temporary space available. If lwork == -1, instead of performing Getri,
+// the optimal work length will be stored into work[0].
+func Getri(n int, a []float32, lda int, ipiv []int, work []float32, lwork int) (ok bool) {
+	iws := max(1, n)
+	switch {
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	case lwork < iws && lwork != -1:
+		panic(lapack.ErrBadLWork)
+	case len(work) < max(1, lwork):
+		panic(lapack.ErrShortWork)
+	}
+
+	if n == 0 {
+		work[0] = 1
+		return true
+	}
+
+	nb := Ilaenv(1, "DGETRI", " ", n, -1, -1, -1)
+	if lwork == -1 {
+		work[0] = float32(n * nb)
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(lapack.ErrShortA)
+	case len(ipiv) != n:
+		panic(lapack.ErrBadLenIpiv)
+	}
+
+	// Form inv(U).
+	ok = Trtri(blas.Upper, blas.NonUnit, n, a, lda)
+	if !ok {
+		return false
+	}
+
+	nbmin := 2
+	if 1 < nb && nb < n {
+		iws = max(n*nb, 1)
+		if lwork < iws {
+			nb = lwork / n
+			nbmin = max(2, Ilaenv(2, "DGETRI", " ", n, -1, -1, -1))
+		}
+	}
+	ldwork := nb
+	// Solve the equation inv(A)*L = inv(U) for inv(A).
+	if nb < nbmin || n <= nb {
+		// Unblocked code.
+		for j := n - 1; j >= 0; j-- {
+			for i := j + 1; i < n; i++ {
+				// Copy current column of L to work and replace with zeros.
+				work[i] = a[i*lda+j]
+				a[i*lda+j] = 0
+			}
+			// Compute current column of inv(A).
+			if j < n-1 {
+				blas32.Gemv(blas.NoTrans, n, n-j-1, -1, a[(j+1):], lda, work[(j+1):], 1, 1, a[j:], lda)
+			}
+		}
+	} else {
+		// Blocked code.
+		nn := ((n - 1) / nb) * nb
+		for j := nn; j >= 0; j -= nb {
+			jb := min(nb, n-j)
+			// Copy current block column of L to work and replace
+			// with zeros.
+			for jj := j; jj < j+jb; jj++ {
+				for i := jj + 1; i < n; i++ {
+					work[i*ldwork+(jj-j)] = a[i*lda+jj]
+					a[i*lda+jj] = 0
+				}
+			}
+			// Compute current block column of inv(A).
+			if j+jb < n {
+				blas32.Gemm(blas.NoTrans, blas.NoTrans, n, jb, n-j-jb, -1, a[(j+jb):], lda, work[(j+jb)*ldwork:], ldwork, 1, a[j:], lda)
+			}
+			blas32.Trsm(blas.Right, blas.Lower, blas.NoTrans, blas.Unit, n, jb, 1, work[j*ldwork:], ldwork, a[j:], lda)
+		}
+	}
+	// Apply column interchanges.
+	for j := n - 2; j >= 0; j-- {
+		jp := ipiv[j]
+		if jp != j {
+			blas32.Swap(n, a[j:], lda, a[jp:], lda)
+		}
+	}
+	work[0] = float32(iws)
+	return true
+}
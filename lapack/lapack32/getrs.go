@@ -0,0 +1,73 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/blas32"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Getrs solves a system of equations using an LU factorization.
+// The system of equations solved is
+//
+//	A * X = B  if trans == blas.NoTrans
+//	Aᵀ * X = B if trans == blas.Trans
+//
+// A is a general n×n matrix with stride lda. B is a general matrix of size n×nrhs.
+//
+// On entry b contains the elements of the matrix B. On exit, b contains the
+// elements of X, the solution to the system of equations.
+//
+// a and ipiv contain the LU factorization of A and the permutation indices as
+// computed by Getrf. ipiv is zero-indexed.
+func Getrs(trans blas.Transpose, n, nrhs int, a []float32, lda int, ipiv []int, b []float32, ldb int) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(lapack.ErrBadTrans)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case nrhs < 0:
+		panic(lapack.ErrNrhsLT0)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	case ldb < max(1, nrhs):
+		panic(lapack.ErrBadLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(lapack.ErrShortA)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(lapack.ErrShortB)
+	case len(ipiv) != n:
+		panic(lapack.ErrBadLenIpiv)
+	}
+
+	if trans == blas.NoTrans {
+		// Solve A * X = B.
+		Laswp(nrhs, b, ldb, 0, n-1, ipiv, 1)
+		// Solve L * X = B, updating b.
+		blas32.Trsm(blas.Left, blas.Lower, blas.NoTrans, blas.Unit,
+			n, nrhs, 1, a, lda, b, ldb)
+		// Solve U * X = B, updating b.
+		blas32.Trsm(blas.Left, blas.Upper, blas.NoTrans, blas.NonUnit,
+			n, nrhs, 1, a, lda, b, ldb)
+		return
+	}
+	// Solve Aᵀ * X = B.
+	// Solve Uᵀ * X = B, updating b.
+	blas32.Trsm(blas.Left, blas.Upper, blas.Trans, blas.NonUnit,
+		n, nrhs, 1, a, lda, b, ldb)
+	// Solve Lᵀ * X = B, updating b.
+	blas32.Trsm(blas.Left, blas.Lower, blas.Trans, blas.Unit,
+		n, nrhs, 1, a, lda, b, ldb)
+	Laswp(nrhs, b, ldb, 0, n-1, ipiv, -1)
+}
@@ -0,0 +1,396 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import "github.com/gocnn/gomat/lapack"
+
+// Ilaenv returns algorithm tuning parameters for the algorithm given by the
+// input string. ispec specifies the parameter to return:
+//
+//	1: The optimal block size for a blocked algorithm.
+//	2: The minimum block size for a blocked algorithm.
+//	3: The block size of unprocessed data at which a blocked algorithm should
+//	   crossover to an unblocked version.
+//	4: The number of shifts.
+//	5: The minimum column dimension for blocking to be used.
+//	6: The crossover point for SVD (to use QR factorization or not).
+//	7: The number of processors.
+//	8: The crossover point for multi-shift in QR and QZ methods for non-symmetric eigenvalue problems.
+//	9: Maximum size of the subproblems in divide-and-conquer algorithms.
+//	10: ieee infinity and NaN arithmetic can be trusted not to trap.
+//	11: ieee infinity arithmetic can be trusted not to trap.
+//	12...16: parameters for Hseqr and related functions. See Iparmq for more
+//	         information.
+//
+// Ilaenv is an internal routine.
+func Ilaenv(ispec int, name string, opts string, n1, n2, n3, n4 int) int {
+	sname := name[0] == 'S' || name[0] == 'D'
+	cname := name[0] == 'C' || name[0] == 'Z'
+	if !sname && !cname {
+		panic(lapack.ErrBadName)
+	}
+	c2 := name[1:3]
+	c3 := name[3:6]
+	c4 := c3[1:3]
+
+	switch ispec {
+	default:
+		panic(lapack.ErrBadIspec)
+	case 1:
+		switch c2 {
+		default:
+			panic(lapack.ErrBadName)
+		case "GE":
+			switch c3 {
+			default:
+				panic(lapack.ErrBadName)
+			case "TRF":
+				if sname {
+					return 64
+				}
+				return 64
+			case "QRF", "RQF", "LQF", "QLF":
+				if sname {
+					return 32
+				}
+				return 32
+			case "HRD":
+				if sname {
+					return 32
+				}
+				return 32
+			case "BRD":
+				if sname {
+					return 32
+				}
+				return 32
+			case "TRI":
+				if sname {
+					return 64
+				}
+				return 64
+			}
+		case "PO":
+			switch c3 {
+			default:
+				panic(lapack.ErrBadName)
+			case "TRF":
+				if sname {
+					return 64
+				}
+				return 64
+			}
+		case "SY":
+			switch c3 {
+			default:
+				panic(lapack.ErrBadName)
+			case "TRF":
+				if sname {
+					return 64
+				}
+				return 64
+			case "TRD":
+				return 32
+			case "GST":
+				return 64
+			}
+		case "HE":
+			switch c3 {
+			default:
+				panic(lapack.ErrBadName)
+			case "TRF":
+				return 64
+			case "TRD":
+				return 32
+			case "GST":
+				return 64
+			}
+		case "OR":
+			switch c3[0] {
+			default:
+				panic(lapack.ErrBadName)
+			case 'G':
+				switch c3[1:] {
+				default:
+					panic(lapack.ErrBadName)
+				case "QR", "RQ", "LQ", "QL", "HR", "TR", "BR":
+					return 32
+				}
+			case 'M':
+				switch c3[1:] {
+				default:
+					panic(lapack.ErrBadName)
+				case "QR", "RQ", "LQ", "QL", "HR", "TR", "BR":
+					return 32
+				}
+			}
+		case "UN":
+			switch c3[0] {
+			default:
+				panic(lapack.ErrBadName)
+			case 'G':
+				switch c3[1:] {
+				default:
+					panic(lapack.ErrBadName)
+				case "QR", "RQ", "LQ", "QL", "HR", "TR", "BR":
+					return 32
+				}
+			case 'M':
+				switch c3[1:] {
+				default:
+					panic(lapack.ErrBadName)
+				case "QR", "RQ", "LQ", "QL", "HR", "TR", "BR":
+					return 32
+				}
+			}
+		case "GB":
+			switch c3 {
+			default:
+				panic(lapack.ErrBadName)
+			case "TRF":
+				if sname {
+					if n4 <= 64 {
+						return 1
+					}
+					return 32
+				}
+				if n4 <= 64 {
+					return 1
+				}
+				return 32
+			}
+		case "PB":
+			switch c3 {
+			default:
+				panic(lapack.ErrBadName)
+			case "TRF":
+				if sname {
+					if n2 <= 64 {
+						return 1
+					}
+					return 32
+				}
+				if n2 <= 64 {
+					return 1
+				}
+				return 32
+			}
+		case "PT":
+			switch c3 {
+			default:
+				panic(lapack.ErrBadName)
+			case "TRS":
+				return 1
+			}
+		case "TR":
+			switch c3 {
+			default:
+				panic(lapack.ErrBadName)
+			case "TRI":
+				if sname {
+					return 64
+				}
+				return 64
+			case "EVC":
+				if sname {
+					return 64
+				}
+				return 64
+			}
+		case "LA":
+			switch c3 {
+			default:
+				panic(lapack.ErrBadName)
+			case "UUM":
+				if sname {
+					return 64
+				}
+				return 64
+			}
+		case "ST":
+			if sname && c3 == "EBZ" {
+				return 1
+			}
+			panic(lapack.ErrBadName)
+		}
+	case 2:
+		switch c2 {
+		default:
+			panic(lapack.ErrBadName)
+		case "GE":
+			switch c3 {
+			default:
+				panic(lapack.ErrBadName)
+			case "QRF", "RQF", "LQF", "QLF":
+				if sname {
+					return 2
+				}
+				return 2
+			case "HRD":
+				if sname {
+					return 2
+				}
+				return 2
+			case "BRD":
+				if sname {
+					return 2
+				}
+				return 2
+			case "TRI":
+				if sname {
+					return 2
+				}
+				return 2
+			}
+		case "SY":
+			switch c3 {
+			default:
+				panic(lapack.ErrBadName)
+			case "TRF":
+				if sname {
+					return 8
+				}
+				return 8
+			case "TRD":
+				if sname {
+					return 2
+				}
+				panic(lapack.ErrBadName)
+			}
+		case "HE":
+			if c3 == "TRD" {
+				return 2
+			}
+			panic(lapack.ErrBadName)
+		case "OR":
+			if !sname {
+				panic(lapack.ErrBadName)
+			}
+			switch c3[0] {
+			default:
+				panic(lapack.ErrBadName)
+			case 'G':
+				switch c4 {
+				default:
+					panic(lapack.ErrBadName)
+				case "QR", "RQ", "LQ", "QL", "HR", "TR", "BR":
+					return 2
+				}
+			case 'M':
+				switch c4 {
+				default:
+					panic(lapack.ErrBadName)
+				case "QR", "RQ", "LQ", "QL", "HR", "TR", "BR":
+					return 2
+				}
+			}
+		case "UN":
+			switch c3[0] {
+			default:
+				panic(lapack.ErrBadName)
+			case 'G':
+				switch c4 {
+				default:
+					panic(lapack.ErrBadName)
+				case "QR", "RQ", "LQ", "QL", "HR", "TR", "BR":
+					return 2
+				}
+			case 'M':
+				switch c4 {
+				default:
+					panic(lapack.ErrBadName)
+				case "QR", "RQ", "LQ", "QL", "HR", "TR", "BR":
+					return 2
+				}
+			}
+		}
+	case 3:
+		switch c2 {
+		default:
+			panic(lapack.ErrBadName)
+		case "GE":
+			switch c3 {
+			default:
+				panic(lapack.ErrBadName)
+			case "QRF", "RQF", "LQF", "QLF":
+				if sname {
+					return 128
+				}
+				return 128
+			case "HRD":
+				if sname {
+					return 128
+				}
+				return 128
+			case "BRD":
+				if sname {
+					return 128
+				}
+				return 128
+			}
+		case "SY":
+			if sname && c3 == "TRD" {
+				return 32
+			}
+			panic(lapack.ErrBadName)
+		case "HE":
+			if c3 == "TRD" {
+				return 32
+			}
+			panic(lapack.ErrBadName)
+		case "OR":
+			switch c3[0] {
+			default:
+				panic(lapack.ErrBadName)
+			case 'G':
+				switch c4 {
+				default:
+					panic(lapack.ErrBadName)
+				case "QR", "RQ", "LQ", "QL", "HR", "TR", "BR":
+					return 128
+				}
+			}
+		case "UN":
+			switch c3[0] {
+			default:
+				panic(lapack.ErrBadName)
+			case 'G':
+				switch c4 {
+				default:
+					panic(lapack.ErrBadName)
+				case "QR", "RQ", "LQ", "QL", "HR", "TR", "BR":
+					return 128
+				}
+			}
+		}
+	case 4:
+		// Used by xHSEQR
+		return 6
+	case 5:
+		// Not used
+		return 2
+	case 6:
+		// Used by xGELSS and xGESVD
+		return int(float32(min(n1, n2)) * 1.6)
+	case 7:
+		// Not used
+		return 1
+	case 8:
+		// Used by xHSEQR
+		return 50
+	case 9:
+		// used by xGELSD and xGESDD
+		return 25
+	case 10:
+		// Go guarantees ieee
+		return 1
+	case 11:
+		// Go guarantees ieee
+		return 1
+	case 12, 13, 14, 15, 16:
+		// Hseqr and related functions for eigenvalue problems.
+		return Iparmq(ispec, name, opts, n1, n2, n3, n4)
+	}
+}
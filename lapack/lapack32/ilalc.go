@@ -0,0 +1,47 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import "github.com/gocnn/gomat/lapack"
+
+// Ilalc scans a matrix for its last non-zero column. Returns -1 if the matrix
+// is all zeros.
+//
+// Ilalc is an internal routine.
+func Ilalc(m, n int, a []float32, lda int) int {
+	switch {
+	case m < 0:
+		panic(lapack.ErrMLT0)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	}
+
+	if n == 0 || m == 0 {
+		return -1
+	}
+
+	if len(a) < (m-1)*lda+n {
+		panic(lapack.ErrShortA)
+	}
+
+	// Test common case where corner is non-zero.
+	if a[n-1] != 0 || a[(m-1)*lda+(n-1)] != 0 {
+		return n - 1
+	}
+
+	// Scan each row tracking the highest column seen.
+	highest := -1
+	for i := 0; i < m; i++ {
+		for j := n - 1; j >= 0; j-- {
+			if a[i*lda+j] != 0 {
+				highest = max(highest, j)
+				break
+			}
+		}
+	}
+	return highest
+}
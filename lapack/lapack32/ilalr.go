@@ -0,0 +1,43 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import "github.com/gocnn/gomat/lapack"
+
+// Ilalr scans a matrix for its last non-zero row. Returns -1 if the matrix
+// is all zeros.
+//
+// Ilalr is an internal routine.
+func Ilalr(m, n int, a []float32, lda int) int {
+	switch {
+	case m < 0:
+		panic(lapack.ErrMLT0)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	}
+
+	if n == 0 || m == 0 {
+		return -1
+	}
+
+	if len(a) < (m-1)*lda+n {
+		panic(lapack.ErrShortA)
+	}
+
+	// Check the common case where the corner is non-zero
+	if a[(m-1)*lda] != 0 || a[(m-1)*lda+n-1] != 0 {
+		return m - 1
+	}
+	for i := m - 1; i >= 0; i-- {
+		for j := 0; j < n; j++ {
+			if a[i*lda+j] != 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
@@ -0,0 +1,136 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	math "github.com/gocnn/gomat/internal/math32"
+
+	"github.com/gocnn/gomat/blas/blas32"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Lacn2 estimates the 1-norm of an n×n matrix A using sequential updates with
+// matrix-vector products provided externally.
+//
+// Lacn2 is called sequentially and it returns the value of est and kase to be
+// used on the next call.
+// On the initial call, kase must be 0.
+// In between calls, x must be overwritten by
+//
+//	A * X    if kase was returned as 1,
+//	Aᵀ * X   if kase was returned as 2,
+//
+// and all other parameters must not be changed.
+// On the final return, kase is returned as 0, v contains A*W where W is a
+// vector, and est = norm(V)/norm(W) is a lower bound for 1-norm of A.
+//
+// v, x, and isgn must all have length n and n must be at least 1, otherwise
+// Lacn2 will panic. isave is used for temporary storage.
+//
+// Lacn2 is an internal routine.
+func Lacn2(n int, v, x []float32, isgn []int, est float32, kase int, isave *[3]int) (float32, int) {
+	switch {
+	case n < 1:
+		panic(lapack.ErrNLT1)
+	case len(v) < n:
+		panic(lapack.ErrShortV)
+	case len(x) < n:
+		panic(lapack.ErrShortX)
+	case len(isgn) < n:
+		panic(lapack.ErrShortIsgn)
+	case isave[0] < 0 || 5 < isave[0]:
+		panic(lapack.ErrBadIsave)
+	case isave[0] == 0 && kase != 0:
+		panic(lapack.ErrBadIsave)
+	}
+
+	const itmax = 5
+
+	if kase == 0 {
+		for i := 0; i < n; i++ {
+			x[i] = 1 / float32(n)
+		}
+		kase = 1
+		isave[0] = 1
+		return est, kase
+	}
+	switch isave[0] {
+	case 1:
+		if n == 1 {
+			v[0] = x[0]
+			est = math.Abs(v[0])
+			kase = 0
+			return est, kase
+		}
+		est = blas32.Asum(n, x, 1)
+		for i := 0; i < n; i++ {
+			x[i] = math.Copysign(1, x[i])
+			isgn[i] = int(x[i])
+		}
+		kase = 2
+		isave[0] = 2
+		return est, kase
+	case 2:
+		isave[1] = blas32.Iamax(n, x, 1)
+		isave[2] = 2
+		for i := 0; i < n; i++ {
+			x[i] = 0
+		}
+		x[isave[1]] = 1
+		kase = 1
+		isave[0] = 3
+		return est, kase
+	case 3:
+		blas32.Copy(n, x, 1, v, 1)
+		estold := est
+		est = blas32.Asum(n, v, 1)
+		sameSigns := true
+		for i := 0; i < n; i++ {
+			if int(math.Copysign(1, x[i])) != isgn[i] {
+				sameSigns = false
+				break
+			}
+		}
+		if !sameSigns && est > estold {
+			for i := 0; i < n; i++ {
+				x[i] = math.Copysign(1, x[i])
+				isgn[i] = int(x[i])
+			}
+			kase = 2
+			isave[0] = 4
+			return est, kase
+		}
+	case 4:
+		jlast := isave[1]
+		isave[1] = blas32.Iamax(n, x, 1)
+		if x[jlast] != math.Abs(x[isave[1]]) && isave[2] < itmax {
+			isave[2] += 1
+			for i := 0; i < n; i++ {
+				x[i] = 0
+			}
+			x[isave[1]] = 1
+			kase = 1
+			isave[0] = 3
+			return est, kase
+		}
+	case 5:
+		tmp := 2 * (blas32.Asum(n, x, 1)) / float32(3*n)
+		if tmp > est {
+			blas32.Copy(n, x, 1, v, 1)
+			est = tmp
+		}
+		kase = 0
+		return est, kase
+	}
+	// Iteration complete. Final stage
+	altsgn := float32(1)
+	for i := 0; i < n; i++ {
+		x[i] = altsgn * (1 + float32(i)/float32(n-1))
+		altsgn *= -1
+	}
+	kase = 1
+	isave[0] = 5
+	return est, kase
+}
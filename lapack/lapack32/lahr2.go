@@ -0,0 +1,201 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/blas32"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Lahr2 reduces the first nb columns of a real general n×(n-k+1) matrix A so
+// that elements below the k-th subdiagonal are zero. The reduction is performed
+// by an orthogonal similarity transformation Qᵀ * A * Q. Lahr2 returns the
+// matrices V and T which determine Q as a block reflector I - V*T*Vᵀ, and
+// also the matrix Y = A * V * T.
+//
+// The matrix Q is represented as a product of nb elementary reflectors
+//
+//	Q = H_0 * H_1 * ... * H_{nb-1}.
+//
+// Each H_i has the form
+//
+//	H_i = I - tau[i] * v * vᵀ,
+//
+// where v is a real vector with v[0:i+k-1] = 0 and v[i+k-1] = 1. v[i+k:n] is
+// stored on exit in A[i+k+1:n,i].
+//
+// The elements of the vectors v together form the (n-k+1)×nb matrix
+// V which is needed, with T and Y, to apply the transformation to the
+// unreduced part of the matrix, using an update of the form
+//
+//	A = (I - V*T*Vᵀ) * (A - Y*Vᵀ).
+//
+// On entry, a contains the n×(n-k+1) general matrix A. On return, the elements
+// on and above the k-th subdiagonal in the first nb columns are overwritten
+// with the corresponding elements of the reduced matrix; the elements below the
+// k-th subdiagonal, with the slice tau, represent the matrix Q as a product of
+// elementary reflectors. The other columns of A are unchanged.
+//
+// The contents of A on exit are illustrated by the following example
+// with n = 7, k = 3 and nb = 2:
+//
+//	[ a   a   a   a   a ]
+//	[ a   a   a   a   a ]
+//	[ a   a   a   a   a ]
+//	[ h   h   a   a   a ]
+//	[ v0  h   a   a   a ]
+//	[ v0  v1  a   a   a ]
+//	[ v0  v1  a   a   a ]
+//
+// where a denotes an element of the original matrix A, h denotes a
+// modified element of the upper Hessenberg matrix H, and vi denotes an
+// element of the vector defining H_i.
+//
+// k is the offset for the reduction. Elements below the k-th subdiagonal in the
+// first nb columns are reduced to zero.
+//
+// nb is the number of columns to be reduced.
+//
+// On entry, a represents the n×(n-k+1) matrix A. On return, the elements on and
+// above the k-th subdiagonal in the first nb columns are overwritten with the
+// corresponding elements of the reduced matrix. The elements below the k-th
+// subdiagonal, with the slice tau, represent the matrix Q as a product of
+// elementary reflectors. The other columns of A are unchanged.
+//
+// tau will contain the scalar factors of the elementary reflectors. It must
+// have length at least nb.
+//
+// t and ldt represent the nb×nb upper triangular matrix T, and y and ldy
+// represent the n×nb matrix Y.
+//
+// Lahr2 is an internal routine.
+func Lahr2(n, k, nb int, a []float32, lda int, tau, t []float32, ldt int, y []float32, ldy int) {
+	switch {
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case k < 0:
+		panic(lapack.ErrKLT0)
+	case nb < 0:
+		panic(lapack.ErrNbLT0)
+	case nb > n:
+		panic(lapack.ErrNbGTN)
+	case lda < max(1, n-k+1):
+		panic(lapack.ErrBadLdA)
+	case ldt < max(1, nb):
+		panic(lapack.ErrBadLdT)
+	case ldy < max(1, nb):
+		panic(lapack.ErrBadLdY)
+	}
+
+	// Quick return if possible.
+	if n < 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n-k+1:
+		panic(lapack.ErrShortA)
+	case len(tau) < nb:
+		panic(lapack.ErrShortTau)
+	case len(t) < (nb-1)*ldt+nb:
+		panic(lapack.ErrShortT)
+	case len(y) < (n-1)*ldy+nb:
+		panic(lapack.ErrShortY)
+	}
+
+	// Quick return if possible.
+	if n == 1 {
+		return
+	}
+	var ei float32
+	for i := 0; i < nb; i++ {
+		if i > 0 {
+			// Update A[k:n,i].
+
+			// Update i-th column of A - Y * Vᵀ.
+			blas32.Gemv(blas.NoTrans, n-k, i,
+				-1, y[k*ldy:], ldy,
+				a[(k+i-1)*lda:], 1,
+				1, a[k*lda+i:], lda)
+
+			// Apply I - V * Tᵀ * Vᵀ to this column (call it b)
+			// from the left, using the last column of T as
+			// workspace.
+			// Let V = [ V1 ]   and   b = [ b1 ]   (first i rows)
+			//         [ V2 ]             [ b2 ]
+			// where V1 is unit lower triangular.
+			//
+			// w := V1ᵀ * b1.
+			blas32.Copy(i, a[k*lda+i:], lda, t[nb-1:], ldt)
+			blas32.Trmv(blas.Lower, blas.Trans, blas.Unit, i,
+				a[k*lda:], lda, t[nb-1:], ldt)
+
+			// w := w + V2ᵀ * b2.
+			blas32.Gemv(blas.Trans, n-k-i, i,
+				1, a[(k+i)*lda:], lda,
+				a[(k+i)*lda+i:], lda,
+				1, t[nb-1:], ldt)
+
+			// w := Tᵀ * w.
+			blas32.Trmv(blas.Upper, blas.Trans, blas.NonUnit, i,
+				t, ldt, t[nb-1:], ldt)
+
+			// b2 := b2 - V2*w.
+			blas32.Gemv(blas.NoTrans, n-k-i, i,
+				-1, a[(k+i)*lda:], lda,
+				t[nb-1:], ldt,
+				1, a[(k+i)*lda+i:], lda)
+
+			// b1 := b1 - V1*w.
+			blas32.Trmv(blas.Lower, blas.NoTrans, blas.Unit, i,
+				a[k*lda:], lda, t[nb-1:], ldt)
+			blas32.Axpy(i, -1, t[nb-1:], ldt, a[k*lda+i:], lda)
+
+			a[(k+i-1)*lda+i-1] = ei
+		}
+
+		// Generate the elementary reflector H_i to annihilate
+		// A[k+i+1:n,i].
+		ei, tau[i] = Larfg(n-k-i, a[(k+i)*lda+i], a[min(k+i+1, n-1)*lda+i:], lda)
+		a[(k+i)*lda+i] = 1
+
+		// Compute Y[k:n,i].
+		blas32.Gemv(blas.NoTrans, n-k, n-k-i,
+			1, a[k*lda+i+1:], lda,
+			a[(k+i)*lda+i:], lda,
+			0, y[k*ldy+i:], ldy)
+		blas32.Gemv(blas.Trans, n-k-i, i,
+			1, a[(k+i)*lda:], lda,
+			a[(k+i)*lda+i:], lda,
+			0, t[i:], ldt)
+		blas32.Gemv(blas.NoTrans, n-k, i,
+			-1, y[k*ldy:], ldy,
+			t[i:], ldt,
+			1, y[k*ldy+i:], ldy)
+		blas32.Scal(n-k, tau[i], y[k*ldy+i:], ldy)
+
+		// Compute T[0:i,i].
+		blas32.Scal(i, -tau[i], t[i:], ldt)
+		blas32.Trmv(blas.Upper, blas.NoTrans, blas.NonUnit, i,
+			t, ldt, t[i:], ldt)
+
+		t[i*ldt+i] = tau[i]
+	}
+	a[(k+nb-1)*lda+nb-1] = ei
+
+	// Compute Y[0:k,0:nb].
+	Lacpy(blas.All, k, nb, a[1:], lda, y, ldy)
+	blas32.Trmm(blas.Right, blas.Lower, blas.NoTrans, blas.Unit, k, nb,
+		1, a[k*lda:], lda, y, ldy)
+	if n > k+nb {
+		blas32.Gemm(blas.NoTrans, blas.NoTrans, k, nb, n-k-nb,
+			1, a[1+nb:], lda,
+			a[(k+nb)*lda:], lda,
+			1, y, ldy)
+	}
+	blas32.Trmm(blas.Right, blas.Upper, blas.NoTrans, blas.NonUnit, k, nb,
+		1, t, ldt, y, ldy)
+}
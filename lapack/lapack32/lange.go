@@ -0,0 +1,85 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	math "github.com/gocnn/gomat/internal/math32"
+
+	"github.com/gocnn/gomat/internal/mat/f32"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Lange returns the value of the specified norm of a general m×n matrix A:
+//
+//	lapack.MaxAbs:       the maximum absolute value of any element.
+//	lapack.MaxColumnSum: the maximum column sum of the absolute values of the elements (1-norm).
+//	lapack.MaxRowSum:    the maximum row sum of the absolute values of the elements (infinity-norm).
+//	lapack.Frobenius:    the square root of the sum of the squares of the elements (Frobenius norm).
+//
+// If norm == lapack.MaxColumnSum, work must be of length n, and this function will
+// panic otherwise. There are no restrictions on work for the other matrix norms.
+func Lange(norm lapack.MatrixNorm, m, n int, a []float32, lda int, work []float32) float32 {
+	switch {
+	case norm != lapack.MaxRowSum && norm != lapack.MaxColumnSum && norm != lapack.Frobenius && norm != lapack.MaxAbs:
+		panic(lapack.ErrBadNorm)
+	case m < 0:
+		panic(lapack.ErrMLT0)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(lapack.ErrShortA)
+	case norm == lapack.MaxColumnSum && len(work) < n:
+		panic(lapack.ErrShortWork)
+	}
+
+	switch norm {
+	case lapack.MaxAbs:
+		var value float32
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				value = math.Max(value, math.Abs(a[i*lda+j]))
+			}
+		}
+		return value
+	case lapack.MaxColumnSum:
+		for i := 0; i < n; i++ {
+			work[i] = 0
+		}
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				work[j] += math.Abs(a[i*lda+j])
+			}
+		}
+		var value float32
+		for i := 0; i < n; i++ {
+			value = math.Max(value, work[i])
+		}
+		return value
+	case lapack.MaxRowSum:
+		var value float32
+		for i := 0; i < m; i++ {
+			value = math.Max(value, f32.L1Norm(a[i*lda:i*lda+n]))
+		}
+		return value
+	default:
+		// lapack.Frobenius
+		scale := float32(0)
+		sum := float32(1)
+		for i := 0; i < m; i++ {
+			scale, sum = Lassq(n, a[i*lda:], 1, scale, sum)
+		}
+		return scale * math.Sqrt(sum)
+	}
+}
@@ -3,3 +3,53 @@
 // license that can be found in the LICENSE file.
 
 package lapack32
+
+const (
+	// lamchE is the machine epsilon. For IEEE this is 2^{-24}.
+	lamchE float32 = 0x1p-24
+
+	// lamchB is the radix of the machine (the base of the number system).
+	lamchB float32 = 2
+
+	// lamchP is base * eps.
+	lamchP float32 = lamchB * lamchE
+
+	// lamchS is the "safe minimum", that is, the lowest number such that
+	// 1/lamchS does not overflow, or also the smallest normal number.
+	// For IEEE this is 2^{-126}.
+	lamchS float32 = 0x1p-126
+
+	// Blue's scaling constants
+	//
+	// An n-vector x is well-scaled if
+	//  tsml ≤ |xᵢ| ≤ tbig for 0 ≤ i < n and n ≤ 1/lamchP,
+	// where
+	//  tsml = 2^ceil((expmin-1)/2) = 2^ceil((-125-1)/2) = 2^{-63} = 1.0842022e-19
+	//  tbig = 2^floor((expmax-digits+1)/2) = 2^floor((128-24+1)/2) = 2^{52} = 4.5035996e+15
+	// If any xᵢ is not well-scaled, then multiplying small values by ssml and
+	// large values by sbig avoids underflow or overflow when computing the sum
+	// of squares \sum_0^{n-1} (xᵢ)².
+	//  ssml = 2^{-floor((expmin-digits)/2)} = 2^{-floor((-125-24)/2)} = 2^75 = 3.7778932e+22
+	//  sbig = 2^{-ceil((expmax+digits-1)/2)} = 2^{-ceil((128+24-1)/2)} = 2^{-76} = 1.323489e-23
+	//
+	// References:
+	//  - Anderson E. (2017)
+	//    Algorithm 978: Safe Scaling in the Level 1 BLAS
+	//    ACM Trans Math Softw 44:1--28
+	//    https://doi.org/10.1145/3061665
+	//  - Blue, James L. (1978)
+	//    A Portable Fortran Program to Find the Euclidean Norm of a Vector
+	//    ACM Trans Math Softw 4:15--23
+	//    https://doi.org/10.1145/355769.355771
+	tsml float32 = 0x1p-63
+	tbig float32 = 0x1p52
+	ssml float32 = 0x1p75
+	sbig float32 = 0x1p-76
+)
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
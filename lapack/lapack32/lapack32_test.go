@@ -0,0 +1,250 @@
+package lapack32
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/lapack"
+	"github.com/gocnn/gomat/lapack/lapack64"
+)
+
+// The code in this package is generated from lapack64 and the tests in
+// lapack64 cover its algorithms. The tests here check that the generated
+// float32 code and the hand-written constants in lapack32.go work together.
+
+func TestLamch(t *testing.T) {
+	if want := (math.Nextafter32(1, 2) - 1) / 2; lamchE != want {
+		t.Errorf("lamchE = %v, want %v", lamchE, want)
+	}
+	if want := math.Float32frombits(0x00800000); lamchS != want {
+		t.Errorf("lamchS = %v, want the smallest normal number %v", lamchS, want)
+	}
+	if math.IsInf(float64(1/lamchS), 0) || float64(1/lamchS) > math.MaxFloat32 {
+		t.Errorf("1/lamchS overflows")
+	}
+	if lamchP != 2*lamchE {
+		t.Errorf("lamchP = %v, want %v", lamchP, 2*lamchE)
+	}
+}
+
+// randomMatrix returns an m×n matrix with elements uniform in [-1, 1) and row
+// stride n.
+func randomMatrix(m, n int, rnd *rand.Rand) []float32 {
+	a := make([]float32, m*n)
+	for i := range a {
+		a[i] = 2*rnd.Float32() - 1
+	}
+	return a
+}
+
+// mul returns op(A)*op(B) with row stride n computed in float64, where op(A)
+// is m×k with row stride lda and op(B) is k×n with row stride ldb.
+func mul(tA, tB blas.Transpose, m, n, k int, a []float32, lda int, b []float32, ldb int) []float64 {
+	c := make([]float64, m*n)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			var sum float64
+			for l := 0; l < k; l++ {
+				aij, blj := a[i*lda+l], b[l*ldb+j]
+				if tA == blas.Trans {
+					aij = a[l*lda+i]
+				}
+				if tB == blas.Trans {
+					blj = b[j*ldb+l]
+				}
+				sum += float64(aij) * float64(blj)
+			}
+			c[i*n+j] = sum
+		}
+	}
+	return c
+}
+
+// checkResidual reports an error if the Frobenius norm of A - B, for m×n
+// matrices with row stride n, is larger than tol times the size of the
+// problem, the float32 machine epsilon and the norm of A.
+func checkResidual(t *testing.T, name string, m, n int, a []float32, b []float64, size int, tol float64) {
+	t.Helper()
+	var anorm, dnorm float64
+	for i := 0; i < m*n; i++ {
+		anorm += float64(a[i]) * float64(a[i])
+		d := float64(a[i]) - b[i]
+		dnorm += d * d
+	}
+	resid := math.Sqrt(dnorm) / (max(math.Sqrt(anorm), 1) * float64(size) * float64(lamchE))
+	if !(resid <= tol) {
+		t.Errorf("%s: residual %v too large", name, resid)
+	}
+}
+
+// eye returns the n×n identity matrix.
+func eye(n int) []float32 {
+	a := make([]float32, n*n)
+	for i := 0; i < n; i++ {
+		a[i*n+i] = 1
+	}
+	return a
+}
+
+var sizes = []int{1, 5, 40, 100}
+
+func TestGetrf(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range sizes {
+		a := randomMatrix(n, n, rnd)
+		lu := append([]float32(nil), a...)
+		ipiv := make([]int, n)
+		if !Getrf(n, n, lu, n, ipiv) {
+			t.Fatalf("n=%d: unexpected singular matrix", n)
+		}
+		l, u := make([]float32, n*n), make([]float32, n*n)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				switch {
+				case j < i:
+					l[i*n+j] = lu[i*n+j]
+				case j == i:
+					l[i*n+j] = 1
+					u[i*n+j] = lu[i*n+j]
+				default:
+					u[i*n+j] = lu[i*n+j]
+				}
+			}
+		}
+		plu := mul(blas.NoTrans, blas.NoTrans, n, n, n, l, n, u, n)
+		for i := n - 1; i >= 0; i-- {
+			if p := ipiv[i]; p != i {
+				for j := 0; j < n; j++ {
+					plu[i*n+j], plu[p*n+j] = plu[p*n+j], plu[i*n+j]
+				}
+			}
+		}
+		checkResidual(t, fmt.Sprintf("n=%d: A != P*L*U", n), n, n, a, plu, n, 10)
+	}
+}
+
+func TestPotrf(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range sizes {
+		b := randomMatrix(n, n, rnd)
+		bbt := mul(blas.NoTrans, blas.Trans, n, n, n, b, n, b, n)
+		a := make([]float32, n*n)
+		for i := range a {
+			a[i] = float32(bbt[i])
+		}
+		for i := 0; i < n; i++ {
+			a[i*n+i] += float32(n)
+		}
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			f := append([]float32(nil), a...)
+			if !Potrf(uplo, n, f, n) {
+				t.Fatalf("n=%d,uplo=%c: unexpected failure", n, uplo)
+			}
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					if (uplo == blas.Upper && j < i) || (uplo == blas.Lower && j > i) {
+						f[i*n+j] = 0
+					}
+				}
+			}
+			var llt []float64
+			if uplo == blas.Upper {
+				llt = mul(blas.Trans, blas.NoTrans, n, n, n, f, n, f, n)
+			} else {
+				llt = mul(blas.NoTrans, blas.Trans, n, n, n, f, n, f, n)
+			}
+			checkResidual(t, fmt.Sprintf("n=%d,uplo=%c: A != Uᵀ*U or L*Lᵀ", n, uplo), n, n, a, llt, n, 10)
+		}
+	}
+}
+
+func TestGeqrf(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range sizes {
+		a := randomMatrix(n, n, rnd)
+		qr := append([]float32(nil), a...)
+		tau := make([]float32, n)
+		work := make([]float32, 1)
+		Geqrf(n, n, qr, n, tau, work, -1)
+		work = make([]float32, int(work[0]))
+		Geqrf(n, n, qr, n, tau, work, len(work))
+
+		r := make([]float32, n*n)
+		for i := 0; i < n; i++ {
+			copy(r[i*n+i:i*n+n], qr[i*n+i:i*n+n])
+		}
+		Orgqr(n, n, n, qr, n, tau, work, len(work))
+		name := fmt.Sprintf("n=%d", n)
+		checkResidual(t, name+": Q not orthogonal", n, n, eye(n), mul(blas.Trans, blas.NoTrans, n, n, n, qr, n, qr, n), n, 10)
+		checkResidual(t, name+": A != Q*R", n, n, a, mul(blas.NoTrans, blas.NoTrans, n, n, n, qr, n, r, n), n, 10)
+	}
+}
+
+func TestSyev(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range sizes {
+		a := randomMatrix(n, n, rnd)
+		for i := 0; i < n; i++ {
+			for j := 0; j < i; j++ {
+				a[i*n+j] = a[j*n+i]
+			}
+		}
+		v := append([]float32(nil), a...)
+		w := make([]float32, n)
+		work := make([]float32, 1)
+		Syev(lapack.EVCompute, blas.Upper, n, v, n, w, work, -1)
+		work = make([]float32, int(work[0]))
+		name := fmt.Sprintf("n=%d", n)
+		if !Syev(lapack.EVCompute, blas.Upper, n, v, n, w, work, len(work)) {
+			t.Fatalf("%s: no convergence", name)
+		}
+		av := mul(blas.NoTrans, blas.NoTrans, n, n, n, a, n, v, n)
+		vl := make([]float32, n*n)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				vl[i*n+j] = v[i*n+j] * w[j]
+			}
+		}
+		checkResidual(t, name+": A*V != V*Λ", n, n, vl, av, n, 10)
+	}
+}
+
+func TestGesvd(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range sizes {
+		a := randomMatrix(n, n, rnd)
+		s := make([]float32, n)
+		work := make([]float32, 1)
+		Gesvd(lapack.SVDNone, lapack.SVDNone, n, n, a, n, s, nil, 1, nil, 1, work, -1)
+		a64 := make([]float64, n*n)
+		for i, v := range a {
+			a64[i] = float64(v)
+		}
+		work = make([]float32, int(work[0]))
+		name := fmt.Sprintf("n=%d", n)
+		if !Gesvd(lapack.SVDNone, lapack.SVDNone, n, n, a, n, s, nil, 1, nil, 1, work, len(work)) {
+			t.Fatalf("%s: no convergence", name)
+		}
+
+		// The singular values must agree with those computed in float64.
+		s64 := make([]float64, n)
+		work64 := make([]float64, len(work))
+		if !lapack64.Gesvd(lapack.SVDNone, lapack.SVDNone, n, n, a64, n, s64, nil, 1, nil, 1, work64, len(work64)) {
+			t.Fatalf("%s: no convergence in float64", name)
+		}
+		checkResidual(t, name+": singular values", 1, n, s, s64, n, 10)
+	}
+}
+
+func TestLassq(t *testing.T) {
+	// The squares of these elements overflow float32.
+	const big = 1e30
+	x := []float32{big, -big, big, big}
+	scl, smsq := Lassq(len(x), x, 1, 0, 1)
+	if got, want := float64(scl)*math.Sqrt(float64(smsq)), 2*big; math.Abs(got-want) > 1e-6*want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright ©2017 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"github.com/gocnn/gomat/blas/blas32"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Lapll returns the smallest singular value of the n×2 matrix A = [ x y ].
+// The function first computes the QR factorization of A = Q*R, and then computes
+// the SVD of the 2-by-2 upper triangular matrix r.
+//
+// The contents of x and y are overwritten during the call.
+//
+// Lapll is an internal routine.
+func Lapll(n int, x []float32, incX int, y []float32, incY int) float32 {
+	switch {
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case incX <= 0:
+		panic(lapack.ErrBadIncX)
+	case incY <= 0:
+		panic(lapack.ErrBadIncY)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(x) < 1+(n-1)*incX:
+		panic(lapack.ErrShortX)
+	case len(y) < 1+(n-1)*incY:
+		panic(lapack.ErrShortY)
+	}
+
+	// Quick return if possible.
+	if n == 1 {
+		return 0
+	}
+
+	// Compute the QR factorization of the N-by-2 matrix [ X Y ].
+	a00, tau := Larfg(n, x[0], x[incX:], incX)
+	x[0] = 1
+	c := -tau * blas32.Dot(n, x, incX, y, incY)
+	blas32.Axpy(n, c, x, incX, y, incY)
+	a11, _ := Larfg(n-1, y[incY], y[2*incY:], incY)
+
+	// Compute the SVD of 2-by-2 upper triangular matrix.
+	ssmin, _ := Las2(a00, y[0], a11)
+	return ssmin
+}
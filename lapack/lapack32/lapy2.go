@@ -0,0 +1,14 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import math "github.com/gocnn/gomat/internal/math32"
+
+// Lapy2 is the LAPACK version of math.Hypot.
+//
+// Lapy2 is an internal routine.
+func Lapy2(x, y float32) float32 {
+	return math.Hypot(x, y)
+}
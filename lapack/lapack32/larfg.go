@@ -0,0 +1,74 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	math "github.com/gocnn/gomat/internal/math32"
+
+	"github.com/gocnn/gomat/blas/blas32"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Larfg generates an elementary reflector for a Householder matrix. It creates
+// a real elementary reflector of order n such that
+//
+//	H * (alpha) = (beta)
+//	    (    x)   (   0)
+//	Hᵀ * H = I
+//
+// H is represented in the form
+//
+//	H = 1 - tau * (1; v) * (1 vᵀ)
+//
+// where tau is a real scalar.
+//
+// On entry, x contains the vector x, on exit it contains v.
+//
+// Larfg is an internal routine.
+func Larfg(n int, alpha float32, x []float32, incX int) (beta, tau float32) {
+	switch {
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case incX <= 0:
+		panic(lapack.ErrBadIncX)
+	}
+
+	if n <= 1 {
+		return alpha, 0
+	}
+
+	if len(x) < 1+(n-2)*abs(incX) {
+		panic(lapack.ErrShortX)
+	}
+
+	xnorm := blas32.Nrm2(n-1, x, incX)
+	if xnorm == 0 {
+		return alpha, 0
+	}
+	beta = -math.Copysign(Lapy2(alpha, xnorm), alpha)
+	safmin := lamchS / lamchE
+	knt := 0
+	if math.Abs(beta) < safmin {
+		// xnorm and beta may be inaccurate, scale x and recompute.
+		rsafmn := 1 / safmin
+		for {
+			knt++
+			blas32.Scal(n-1, rsafmn, x, incX)
+			beta *= rsafmn
+			alpha *= rsafmn
+			if math.Abs(beta) >= safmin {
+				break
+			}
+		}
+		xnorm = blas32.Nrm2(n-1, x, incX)
+		beta = -math.Copysign(Lapy2(alpha, xnorm), alpha)
+	}
+	tau = (beta - alpha) / beta
+	blas32.Scal(n-1, 1/(alpha-beta), x, incX)
+	for j := 0; j < knt; j++ {
+		beta *= safmin
+	}
+	return beta, tau
+}
@@ -0,0 +1,99 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	math "github.com/gocnn/gomat/internal/math32"
+
+	"github.com/gocnn/gomat/blas/blas32"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Lasq1 computes the singular values of an n×n bidiagonal matrix with diagonal
+// d and off-diagonal e. On exit, d contains the singular values in decreasing
+// order, and e is overwritten. d must have length at least n, e must have
+// length at least n-1, and the input work must have length at least 4*n. Lasq1
+// will panic if these conditions are not met.
+//
+// Lasq1 is an internal routine.
+func Lasq1(n int, d, e, work []float32) (info int) {
+	if n < 0 {
+		panic(lapack.ErrNLT0)
+	}
+
+	if n == 0 {
+		return info
+	}
+
+	switch {
+	case len(d) < n:
+		panic(lapack.ErrShortD)
+	case len(e) < n-1:
+		panic(lapack.ErrShortE)
+	case len(work) < 4*n:
+		panic(lapack.ErrShortWork)
+	}
+
+	if n == 1 {
+		d[0] = math.Abs(d[0])
+		return info
+	}
+
+	if n == 2 {
+		d[1], d[0] = Las2(d[0], e[0], d[1])
+		return info
+	}
+
+	// Estimate the largest singular value.
+	var sigmx float32
+	for i := 0; i < n-1; i++ {
+		d[i] = math.Abs(d[i])
+		sigmx = math.Max(sigmx, math.Abs(e[i]))
+	}
+	d[n-1] = math.Abs(d[n-1])
+	// Early return if sigmx is zero (matrix is already diagonal).
+	if sigmx == 0 {
+		Lasrt(lapack.SortDecreasing, n, d)
+		return info
+	}
+
+	for i := 0; i < n; i++ {
+		sigmx = math.Max(sigmx, d[i])
+	}
+
+	// Copy D and E into WORK (in the Z format) and scale (squaring the
+	// input data makes scaling by a power of the radix pointless).
+
+	eps := lamchP
+	safmin := lamchS
+	scale := math.Sqrt(eps / safmin)
+	blas32.Copy(n, d, 1, work, 2)
+	blas32.Copy(n-1, e, 1, work[1:], 2)
+	Lascl(lapack.General, 0, 0, sigmx, scale, 2*n-1, 1, work, 1)
+
+	// Compute the q's and e's.
+	for i := 0; i < 2*n-1; i++ {
+		work[i] *= work[i]
+	}
+	work[2*n-1] = 0
+
+	info = Lasq2(n, work)
+	if info == 0 {
+		for i := 0; i < n; i++ {
+			d[i] = math.Sqrt(work[i])
+		}
+		Lascl(lapack.General, 0, 0, scale, sigmx, n, 1, d, 1)
+	} else if info == 2 {
+		// Maximum number of iterations exceeded. Move data from work
+		// into D and E so the calling subroutine can try to finish.
+		for i := 0; i < n; i++ {
+			d[i] = math.Sqrt(work[2*i])
+			e[i] = math.Sqrt(work[2*i+1])
+		}
+		Lascl(lapack.General, 0, 0, scale, sigmx, n, 1, d, 1)
+		Lascl(lapack.General, 0, 0, scale, sigmx, n, 1, e, 1)
+	}
+	return info
+}
@@ -0,0 +1,37 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Lasrt sorts the numbers in the input slice d. If s == lapack.SortIncreasing,
+// the elements are sorted in increasing order. If s == lapack.SortDecreasing,
+// the elements are sorted in decreasing order. For other values of s Lasrt
+// will panic.
+//
+// Lasrt is an internal routine.
+func Lasrt(s lapack.Sort, n int, d []float32) {
+	switch {
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case len(d) < n:
+		panic(lapack.ErrShortD)
+	}
+
+	d = d[:n]
+	switch s {
+	default:
+		panic(lapack.ErrBadSort)
+	case lapack.SortIncreasing:
+		slices.Sort(d)
+	case lapack.SortDecreasing:
+		slices.SortFunc(d, func(a, b float32) int { return cmp.Compare(b, a) })
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	math "github.com/gocnn/gomat/internal/math32"
+
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Lassq updates a sum of squares represented in scaled form. Lassq returns
+// the values scl and smsq such that
+//
+//	scl^2*smsq = X[0]^2 + ... + X[n-1]^2 + scale^2*sumsq
+//
+// The value of sumsq is assumed to be non-negative.
+//
+// Lassq is an internal routine.
+func Lassq(n int, x []float32, incx int, scale float32, sumsq float32) (scl, smsq float32) {
+	// Implementation based on Supplemental Material to:
+	// Edward Anderson. 2017. Algorithm 978: Safe Scaling in the Level 1 BLAS.
+	// ACM Trans. Math. Softw. 44, 1, Article 12 (July 2017), 28 pages.
+	// DOI: https://doi.org/10.1145/3061665
+	switch {
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case incx <= 0:
+		panic(lapack.ErrBadIncX)
+	case len(x) < 1+(n-1)*incx:
+		panic(lapack.ErrShortX)
+	}
+
+	if math.IsNaN(scale) || math.IsNaN(sumsq) {
+		return scale, sumsq
+	}
+
+	if sumsq == 0 {
+		scale = 1
+	}
+	if scale == 0 {
+		scale = 1
+		sumsq = 0
+	}
+
+	if n == 0 {
+		return scale, sumsq
+	}
+
+	// Compute the sum of squares in 3 accumulators:
+	//  - abig: sum of squares scaled down to avoid overflow
+	//  - asml: sum of squares scaled up to avoid underflow
+	//  - amed: sum of squares that do not require scaling
+	// The thresholds and multipliers are:
+	//  - values bigger than tbig are scaled down by sbig
+	//  - values smaller than tsml are scaled up by ssml
+	var (
+		isBig            bool
+		asml, amed, abig float32
+	)
+	for i, ix := 0, 0; i < n; i++ {
+		ax := math.Abs(x[ix])
+		switch {
+		case ax > tbig:
+			ax *= sbig
+			abig += ax * ax
+			isBig = true
+		case ax < tsml:
+			if !isBig {
+				ax *= ssml
+				asml += ax * ax
+			}
+		default:
+			amed += ax * ax
+		}
+		ix += incx
+	}
+	// Put the existing sum of squares into one of the accumulators.
+	if sumsq > 0 {
+		ax := scale * math.Sqrt(sumsq)
+		switch {
+		case ax > tbig:
+			if scale > 1 {
+				scale *= sbig
+				abig += scale * (scale * sumsq)
+			} else {
+				// sumsq > tbig^2 => (sbig * (sbig * sumsq)) is representable.
+				abig += scale * (scale * (sbig * (sbig * sumsq)))
+			}
+		case ax < tsml:
+			if !isBig {
+				if scale < 1 {
+					scale *= ssml
+					asml += scale * (scale * sumsq)
+				} else {
+					// sumsq < tsml^2 => (ssml * (ssml * sumsq)) is representable.
+					asml += scale * (scale * (ssml * (ssml * sumsq)))
+				}
+			}
+		default:
+			amed += scale * (scale * sumsq)
+		}
+	}
+	// Combine abig and amed or amed and asml if more than one accumulator was
+	// used.
+	switch {
+	case abig > 0:
+		// Combine abig and amed:
+		if amed > 0 || math.IsNaN(amed) {
+			abig += (amed * sbig) * sbig
+		}
+		scale = 1 / sbig
+		sumsq = abig
+	case asml > 0:
+		// Combine amed and asml:
+		if amed > 0 || math.IsNaN(amed) {
+			amed = math.Sqrt(amed)
+			asml = math.Sqrt(asml) / ssml
+			ymin, ymax := asml, amed
+			if asml > amed {
+				ymin, ymax = amed, asml
+			}
+			scale = 1
+			sumsq = ymax * ymax * (1 + (ymin/ymax)*(ymin/ymax))
+		} else {
+			scale = 1 / ssml
+			sumsq = asml
+		}
+	default:
+		scale = 1
+		sumsq = amed
+	}
+	return scale, sumsq
+}
@@ -0,0 +1,65 @@
+// Copyright ©2018 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/blas32"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Lauu2 computes the product
+//
+//	U * Uᵀ  if uplo is blas.Upper
+//	Lᵀ * L  if uplo is blas.Lower
+//
+// where U or L is stored in the upper or lower triangular part of A.
+// Only the upper or lower triangle of the result is stored, overwriting
+// the corresponding factor in A.
+func Lauu2(uplo blas.Uplo, n int, a []float32, lda int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(lapack.ErrBadUplo)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	if len(a) < (n-1)*lda+n {
+		panic(lapack.ErrShortA)
+	}
+
+	if uplo == blas.Upper {
+		// Compute the product U*Uᵀ.
+		for i := 0; i < n; i++ {
+			aii := a[i*lda+i]
+			if i < n-1 {
+				a[i*lda+i] = blas32.Dot(n-i, a[i*lda+i:], 1, a[i*lda+i:], 1)
+				blas32.Gemv(blas.NoTrans, i, n-i-1, 1, a[i+1:], lda, a[i*lda+i+1:], 1,
+					aii, a[i:], lda)
+			} else {
+				blas32.Scal(i+1, aii, a[i:], lda)
+			}
+		}
+	} else {
+		// Compute the product Lᵀ*L.
+		for i := 0; i < n; i++ {
+			aii := a[i*lda+i]
+			if i < n-1 {
+				a[i*lda+i] = blas32.Dot(n-i, a[i*lda+i:], lda, a[i*lda+i:], lda)
+				blas32.Gemv(blas.Trans, n-i-1, i, 1, a[(i+1)*lda:], lda, a[(i+1)*lda+i:], lda,
+					aii, a[i*lda:], 1)
+			} else {
+				blas32.Scal(i+1, aii, a[i*lda:], 1)
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright ©2018 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/blas32"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Lauum computes the product
+//
+//	U * Uᵀ  if uplo is blas.Upper
+//	Lᵀ * L  if uplo is blas.Lower
+//
+// where U or L is stored in the upper or lower triangular part of A.
+// Only the upper or lower triangle of the result is stored, overwriting
+// the corresponding factor in A.
+func Lauum(uplo blas.Uplo, n int, a []float32, lda int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(lapack.ErrBadUplo)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	if len(a) < (n-1)*lda+n {
+		panic(lapack.ErrShortA)
+	}
+
+	// Determine the block size.
+	opts := "U"
+	if uplo == blas.Lower {
+		opts = "L"
+	}
+	nb := Ilaenv(1, "DLAUUM", opts, n, -1, -1, -1)
+
+	if nb <= 1 || n <= nb {
+		// Use unblocked code.
+		Lauu2(uplo, n, a, lda)
+		return
+	}
+
+	// Use blocked code.
+	if uplo == blas.Upper {
+		// Compute the product U*Uᵀ.
+		for i := 0; i < n; i += nb {
+			ib := min(nb, n-i)
+			blas32.Trmm(blas.Right, blas.Upper, blas.Trans, blas.NonUnit,
+				i, ib, 1, a[i*lda+i:], lda, a[i:], lda)
+			Lauu2(blas.Upper, ib, a[i*lda+i:], lda)
+			if n-i-ib > 0 {
+				blas32.Gemm(blas.NoTrans, blas.Trans, i, ib, n-i-ib,
+					1, a[i+ib:], lda, a[i*lda+i+ib:], lda, 1, a[i:], lda)
+				blas32.Syrk(blas.Upper, blas.NoTrans, ib, n-i-ib,
+					1, a[i*lda+i+ib:], lda, 1, a[i*lda+i:], lda)
+			}
+		}
+	} else {
+		// Compute the product Lᵀ*L.
+		for i := 0; i < n; i += nb {
+			ib := min(nb, n-i)
+			blas32.Trmm(blas.Left, blas.Lower, blas.Trans, blas.NonUnit,
+				ib, i, 1, a[i*lda+i:], lda, a[i*lda:], lda)
+			Lauu2(blas.Lower, ib, a[i*lda+i:], lda)
+			if n-i-ib > 0 {
+				blas32.Gemm(blas.Trans, blas.NoTrans, ib, i, n-i-ib,
+					1, a[(i+ib)*lda+i:], lda, a[(i+ib)*lda:], lda, 1, a[i*lda:], lda)
+				blas32.Syrk(blas.Lower, blas.Trans, ib, n-i-ib,
+					1, a[(i+ib)*lda+i:], lda, 1, a[i*lda+i:], lda)
+			}
+		}
+	}
+}
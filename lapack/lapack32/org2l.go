@@ -0,0 +1,77 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/blas32"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Org2l generates an m×n matrix Q with orthonormal columns which is defined
+// as the last n columns of a product of k elementary reflectors of order m.
+//
+//	Q = H_{k-1} * ... * H_1 * H_0
+//
+// See Gelqf for more information. It must be that m >= n >= k.
+//
+// tau contains the scalar reflectors computed by Geqlf. tau must have length
+// at least k, and Org2l will panic otherwise.
+//
+// work contains temporary memory, and must have length at least n. Org2l will
+// panic otherwise.
+//
+// Org2l is an internal routine.
+func Org2l(m, n, k int, a []float32, lda int, tau, work []float32) {
+	switch {
+	case m < 0:
+		panic(lapack.ErrMLT0)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case n > m:
+		panic(lapack.ErrNGTM)
+	case k < 0:
+		panic(lapack.ErrKLT0)
+	case k > n:
+		panic(lapack.ErrKGTN)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	}
+
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(lapack.ErrShortA)
+	case len(tau) < k:
+		panic(lapack.ErrShortTau)
+	case len(work) < n:
+		panic(lapack.ErrShortWork)
+	}
+
+	// Initialize columns 0:n-k to columns of the unit matrix.
+	for j := 0; j < n-k; j++ {
+		for l := 0; l < m; l++ {
+			a[l*lda+j] = 0
+		}
+		a[(m-n+j)*lda+j] = 1
+	}
+	for i := 0; i < k; i++ {
+		ii := n - k + i
+
+		// Apply H_i to A[0:m-k+i, 0:n-k+i] from the left.
+		a[(m-n+ii)*lda+ii] = 1
+		Larf(blas.Left, m-n+ii+1, ii, a[ii:], lda, tau[i], a, lda, work)
+		blas32.Scal(m-n+ii, -tau[i], a[ii:], lda)
+		a[(m-n+ii)*lda+ii] = 1 - tau[i]
+
+		// Set A[m-k+i:m, n-k+i+1] to zero.
+		for l := m - n + ii + 1; l < m; l++ {
+			a[l*lda+ii] = 0
+		}
+	}
+}
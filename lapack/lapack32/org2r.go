@@ -0,0 +1,76 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/blas32"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Org2r generates an m×n matrix Q with orthonormal columns defined by the
+// product of elementary reflectors as computed by Geqrf.
+//
+//	Q = H_0 * H_1 * ... * H_{k-1}
+//
+// len(tau) = k, 0 <= k <= n, 0 <= n <= m, len(work) >= n.
+// Org2r will panic if these conditions are not met.
+//
+// Org2r is an internal routine.
+func Org2r(m, n, k int, a []float32, lda int, tau []float32, work []float32) {
+	switch {
+	case m < 0:
+		panic(lapack.ErrMLT0)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case n > m:
+		panic(lapack.ErrNGTM)
+	case k < 0:
+		panic(lapack.ErrKLT0)
+	case k > n:
+		panic(lapack.ErrKGTN)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	}
+
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(lapack.ErrShortA)
+	case len(tau) != k:
+		panic(lapack.ErrBadLenTau)
+	case len(work) < n:
+		panic(lapack.ErrShortWork)
+	}
+
+	// Initialize columns k+1:n to columns of the unit matrix.
+	for l := 0; l < m; l++ {
+		for j := k; j < n; j++ {
+			a[l*lda+j] = 0
+		}
+	}
+	for j := k; j < n; j++ {
+		a[j*lda+j] = 1
+	}
+	for i := k - 1; i >= 0; i-- {
+		for i := range work {
+			work[i] = 0
+		}
+		if i < n-1 {
+			a[i*lda+i] = 1
+			Larf(blas.Left, m-i, n-i-1, a[i*lda+i:], lda, tau[i], a[i*lda+i+1:], lda, work)
+		}
+		if i < m-1 {
+			blas32.Scal(m-i-1, -tau[i], a[(i+1)*lda+i:], lda)
+		}
+		a[i*lda+i] = 1 - tau[i]
+		for l := 0; l < i; l++ {
+			a[l*lda+i] = 0
+		}
+	}
+}
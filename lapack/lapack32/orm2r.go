@@ -0,0 +1,106 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Orm2r multiplies a general matrix C by an orthogonal matrix from a QR factorization
+// determined by Geqrf.
+//
+//	C = Q * C   if side == blas.Left and trans == blas.NoTrans
+//	C = Qᵀ * C  if side == blas.Left and trans == blas.Trans
+//	C = C * Q   if side == blas.Right and trans == blas.NoTrans
+//	C = C * Qᵀ  if side == blas.Right and trans == blas.Trans
+//
+// If side == blas.Left, a is a matrix of size m×k, and if side == blas.Right
+// a is of size n×k.
+//
+// tau contains the Householder factors and must have length k and this function
+// will panic otherwise.
+//
+// work is temporary storage of length at least n if side == blas.Left
+// and at least m if side == blas.Right and this function will panic otherwise.
+//
+// Orm2r is an internal routine.
+func Orm2r(side blas.Side, trans blas.Transpose, m, n, k int, a []float32, lda int, tau, c []float32, ldc int, work []float32) {
+	left := side == blas.Left
+	switch {
+	case !left && side != blas.Right:
+		panic(lapack.ErrBadSide)
+	case trans != blas.Trans && trans != blas.NoTrans:
+		panic(lapack.ErrBadTrans)
+	case m < 0:
+		panic(lapack.ErrMLT0)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case k < 0:
+		panic(lapack.ErrKLT0)
+	case left && k > m:
+		panic(lapack.ErrKGTM)
+	case !left && k > n:
+		panic(lapack.ErrKGTN)
+	case lda < max(1, k):
+		panic(lapack.ErrBadLdA)
+	case ldc < max(1, n):
+		panic(lapack.ErrBadLdC)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || k == 0 {
+		return
+	}
+
+	switch {
+	case left && len(a) < (m-1)*lda+k:
+		panic(lapack.ErrShortA)
+	case !left && len(a) < (n-1)*lda+k:
+		panic(lapack.ErrShortA)
+	case len(c) < (m-1)*ldc+n:
+		panic(lapack.ErrShortC)
+	case len(tau) != k:
+		panic(lapack.ErrBadLenTau)
+	case left && len(work) < n:
+		panic(lapack.ErrShortWork)
+	case !left && len(work) < m:
+		panic(lapack.ErrShortWork)
+	}
+
+	if left {
+		if trans == blas.NoTrans {
+			for i := k - 1; i >= 0; i-- {
+				aii := a[i*lda+i]
+				a[i*lda+i] = 1
+				Larf(side, m-i, n, a[i*lda+i:], lda, tau[i], c[i*ldc:], ldc, work)
+				a[i*lda+i] = aii
+			}
+			return
+		}
+		for i := 0; i < k; i++ {
+			aii := a[i*lda+i]
+			a[i*lda+i] = 1
+			Larf(side, m-i, n, a[i*lda+i:], lda, tau[i], c[i*ldc:], ldc, work)
+			a[i*lda+i] = aii
+		}
+		return
+	}
+	if trans == blas.NoTrans {
+		for i := 0; i < k; i++ {
+			aii := a[i*lda+i]
+			a[i*lda+i] = 1
+			Larf(side, m, n-i, a[i*lda+i:], lda, tau[i], c[i:], ldc, work)
+			a[i*lda+i] = aii
+		}
+		return
+	}
+	for i := k - 1; i >= 0; i-- {
+		aii := a[i*lda+i]
+		a[i*lda+i] = 1
+		Larf(side, m, n-i, a[i*lda+i:], lda, tau[i], c[i:], ldc, work)
+		a[i*lda+i] = aii
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Ormbr applies a multiplicative update to the matrix C based on a
+// decomposition computed by Gebrd.
+//
+// Ormbr overwrites the m×n matrix C with
+//
+//	Q * C   if vect == lapack.ApplyQ, side == blas.Left, and trans == blas.NoTrans
+//	C * Q   if vect == lapack.ApplyQ, side == blas.Right, and trans == blas.NoTrans
+//	Qᵀ * C  if vect == lapack.ApplyQ, side == blas.Left, and trans == blas.Trans
+//	C * Qᵀ  if vect == lapack.ApplyQ, side == blas.Right, and trans == blas.Trans
+//
+//	P * C   if vect == lapack.ApplyP, side == blas.Left, and trans == blas.NoTrans
+//	C * P   if vect == lapack.ApplyP, side == blas.Right, and trans == blas.NoTrans
+//	Pᵀ * C  if vect == lapack.ApplyP, side == blas.Left, and trans == blas.Trans
+//	C * Pᵀ  if vect == lapack.ApplyP, side == blas.Right, and trans == blas.Trans
+//
+// where P and Q are the orthogonal matrices determined by Gebrd when reducing
+// a matrix A to bidiagonal form: A = Q * B * Pᵀ. See Gebrd for the
+// definitions of Q and P.
+//
+// If vect == lapack.ApplyQ, A is assumed to have been an nq×k matrix, while if
+// vect == lapack.ApplyP, A is assumed to have been a k×nq matrix. nq = m if
+// side == blas.Left, while nq = n if side == blas.Right.
+//
+// tau must have length min(nq,k), and Ormbr will panic otherwise. tau contains
+// the elementary reflectors to construct Q or P depending on the value of
+// vect.
+//
+// work must have length at least max(1,lwork), and lwork must be either -1 or
+// at least max(1,n) if side == blas.Left, and at least max(1,m) if side ==
+// blas.Right. For optimum performance lwork should be at least n*nb if side ==
+// blas.Left, and at least m*nb if side == blas.Right, where nb is the optimal
+// block size. On return, work[0] will contain the optimal value of lwork.
+//
+// If lwork == -1, the function only calculates the optimal value of lwork and
+// returns it in work[0].
+//
+// Ormbr is an internal routine.
+func Ormbr(vect lapack.ApplyOrtho, side blas.Side, trans blas.Transpose, m, n, k int, a []float32, lda int, tau, c []float32, ldc int, work []float32, lwork int) {
+	nq := n
+	nw := m
+	if side == blas.Left {
+		nq = m
+		nw = n
+	}
+	applyQ := vect == lapack.ApplyQ
+	switch {
+	case !applyQ && vect != lapack.ApplyP:
+		panic(lapack.ErrBadApplyOrtho)
+	case side != blas.Left && side != blas.Right:
+		panic(lapack.ErrBadSide)
+	case trans != blas.NoTrans && trans != blas.Trans:
+		panic(lapack.ErrBadTrans)
+	case m < 0:
+		panic(lapack.ErrMLT0)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case k < 0:
+		panic(lapack.ErrKLT0)
+	case applyQ && lda < max(1, min(nq, k)):
+		panic(lapack.ErrBadLdA)
+	case !applyQ && lda < max(1, nq):
+		panic(lapack.ErrBadLdA)
+	case ldc < max(1, n):
+		panic(lapack.ErrBadLdC)
+	case lwork < max(1, nw) && lwork != -1:
+		panic(lapack.ErrBadLWork)
+	case len(work) < max(1, lwork):
+		panic(lapack.ErrShortWork)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		work[0] = 1
+		return
+	}
+
+	// The current implementation does not use opts, but a future change may
+	// use these options so construct them.
+	var opts string
+	if side == blas.Left {
+		opts = "L"
+	} else {
+		opts = "R"
+	}
+	if trans == blas.Trans {
+		opts += "T"
+	} else {
+		opts += "N"
+	}
+	var nb int
+	if applyQ {
+		if side == blas.Left {
+			nb = Ilaenv(1, "DORMQR", opts, m-1, n, m-1, -1)
+		} else {
+			nb = Ilaenv(1, "DORMQR", opts, m, n-1, n-1, -1)
+		}
+	} else {
+		if side == blas.Left {
+			nb = Ilaenv(1, "DORMLQ", opts, m-1, n, m-1, -1)
+		} else {
+			nb = Ilaenv(1, "DORMLQ", opts, m, n-1, n-1, -1)
+		}
+	}
+	lworkopt := max(1, nw) * nb
+	if lwork == -1 {
+		work[0] = float32(lworkopt)
+		return
+	}
+
+	minnqk := min(nq, k)
+	switch {
+	case applyQ && len(a) < (nq-1)*lda+minnqk:
+		panic(lapack.ErrShortA)
+	case !applyQ && len(a) < (minnqk-1)*lda+nq:
+		panic(lapack.ErrShortA)
+	case len(tau) < minnqk:
+		panic(lapack.ErrShortTau)
+	case len(c) < (m-1)*ldc+n:
+		panic(lapack.ErrShortC)
+	}
+
+	if applyQ {
+		// Change the operation to get Q depending on the size of the initial
+		// matrix to Gebrd. The size matters due to the storage location of
+		// the off-diagonal elements.
+		if nq >= k {
+			Ormqr(side, trans, m, n, k, a, lda, tau[:k], c, ldc, work, lwork)
+		} else if nq > 1 {
+			mi := m
+			ni := n - 1
+			i1 := 0
+			i2 := 1
+			if side == blas.Left {
+				mi = m - 1
+				ni = n
+				i1 = 1
+				i2 = 0
+			}
+			Ormqr(side, trans, mi, ni, nq-1, a[lda:], lda, tau[:nq-1], c[i1*ldc+i2:], ldc, work, lwork)
+		}
+		work[0] = float32(lworkopt)
+		return
+	}
+
+	transt := blas.Trans
+	if trans == blas.Trans {
+		transt = blas.NoTrans
+	}
+
+	// Change the operation to get P depending on the size of the initial
+	// matrix to Gebrd. The size matters due to the storage location of
+	// the off-diagonal elements.
+	if nq > k {
+		Ormlq(side, transt, m, n, k, a, lda, tau, c, ldc, work, lwork)
+	} else if nq > 1 {
+		mi := m
+		ni := n - 1
+		i1 := 0
+		i2 := 1
+		if side == blas.Left {
+			mi = m - 1
+			ni = n
+			i1 = 1
+			i2 = 0
+		}
+		Ormlq(side, transt, mi, ni, nq-1, a[1:], lda, tau, c[i1*ldc+i2:], ldc, work, lwork)
+	}
+	work[0] = float32(lworkopt)
+}
@@ -0,0 +1,137 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Ormhr multiplies an m×n general matrix C with an nq×nq orthogonal matrix Q
+//
+//	Q * C   if side == blas.Left  and trans == blas.NoTrans,
+//	Qᵀ * C  if side == blas.Left  and trans == blas.Trans,
+//	C * Q   if side == blas.Right and trans == blas.NoTrans,
+//	C * Qᵀ  if side == blas.Right and trans == blas.Trans,
+//
+// where nq == m if side == blas.Left and nq == n if side == blas.Right.
+//
+// Q is defined implicitly as the product of ihi-ilo elementary reflectors, as
+// returned by Gehrd:
+//
+//	Q = H_{ilo} H_{ilo+1} ... H_{ihi-1}.
+//
+// Q is equal to the identity matrix except in the submatrix
+// Q[ilo+1:ihi+1,ilo+1:ihi+1].
+//
+// ilo and ihi must have the same values as in the previous call of Gehrd. It
+// must hold that
+//
+//	0 <= ilo <= ihi < m   if m > 0 and side == blas.Left,
+//	ilo = 0 and ihi = -1  if m = 0 and side == blas.Left,
+//	0 <= ilo <= ihi < n   if n > 0 and side == blas.Right,
+//	ilo = 0 and ihi = -1  if n = 0 and side == blas.Right.
+//
+// a and lda represent an m×m matrix if side == blas.Left and an n×n matrix if
+// side == blas.Right. The matrix contains vectors which define the elementary
+// reflectors, as returned by Gehrd.
+//
+// tau contains the scalar factors of the elementary reflectors, as returned by
+// Gehrd. tau must have length m-1 if side == blas.Left and n-1 if side ==
+// blas.Right.
+//
+// c and ldc represent the m×n matrix C. On return, c is overwritten by the
+// product with Q.
+//
+// work must have length at least max(1,lwork), and lwork must be at least
+// max(1,n), if side == blas.Left, and max(1,m), if side == blas.Right. For
+// optimum performance lwork should be at least n*nb if side == blas.Left and
+// m*nb if side == blas.Right, where nb is the optimal block size. On return,
+// work[0] will contain the optimal value of lwork.
+//
+// If lwork == -1, instead of performing Ormhr, only the optimal value of lwork
+// will be stored in work[0].
+//
+// If any requirement on input sizes is not met, Ormhr will panic.
+//
+// Ormhr is an internal routine.
+func Ormhr(side blas.Side, trans blas.Transpose, m, n, ilo, ihi int, a []float32, lda int, tau, c []float32, ldc int, work []float32, lwork int) {
+	nq := n // The order of Q.
+	nw := m // The minimum length of work.
+	if side == blas.Left {
+		nq = m
+		nw = n
+	}
+	switch {
+	case side != blas.Left && side != blas.Right:
+		panic(lapack.ErrBadSide)
+	case trans != blas.NoTrans && trans != blas.Trans:
+		panic(lapack.ErrBadTrans)
+	case m < 0:
+		panic(lapack.ErrMLT0)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case ilo < 0 || max(1, nq) <= ilo:
+		panic(lapack.ErrIloRange)
+	case ihi < min(ilo, nq-1) || nq <= ihi:
+		panic(lapack.ErrIhiRange)
+	case lda < max(1, nq):
+		panic(lapack.ErrBadLdA)
+	case lwork < max(1, nw) && lwork != -1:
+		panic(lapack.ErrBadLWork)
+	case len(work) < max(1, lwork):
+		panic(lapack.ErrShortWork)
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		work[0] = 1
+		return
+	}
+
+	nh := ihi - ilo
+	var nb int
+	if side == blas.Left {
+		opts := "LN"
+		if trans == blas.Trans {
+			opts = "LT"
+		}
+		nb = Ilaenv(1, "DORMQR", opts, nh, n, nh, -1)
+	} else {
+		opts := "RN"
+		if trans == blas.Trans {
+			opts = "RT"
+		}
+		nb = Ilaenv(1, "DORMQR", opts, m, nh, nh, -1)
+	}
+	lwkopt := max(1, nw) * nb
+	if lwork == -1 {
+		work[0] = float32(lwkopt)
+		return
+	}
+
+	if nh == 0 {
+		work[0] = 1
+		return
+	}
+
+	switch {
+	case len(a) < (nq-1)*lda+nq:
+		panic(lapack.ErrShortA)
+	case len(c) < (m-1)*ldc+n:
+		panic(lapack.ErrShortC)
+	case len(tau) != nq-1:
+		panic(lapack.ErrBadLenTau)
+	}
+
+	if side == blas.Left {
+		Ormqr(side, trans, nh, n, nh, a[(ilo+1)*lda+ilo:], lda,
+			tau[ilo:ihi], c[(ilo+1)*ldc:], ldc, work, lwork)
+	} else {
+		Ormqr(side, trans, m, nh, nh, a[(ilo+1)*lda+ilo:], lda,
+			tau[ilo:ihi], c[ilo+1:], ldc, work, lwork)
+	}
+	work[0] = float32(lwkopt)
+}
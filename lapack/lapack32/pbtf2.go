@@ -0,0 +1,113 @@
+// Copyright ©2017 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	math "github.com/gocnn/gomat/internal/math32"
+
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/blas32"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Pbtf2 computes the Cholesky factorization of a symmetric positive banded
+// matrix ab. The matrix ab is n×n with kd diagonal bands. The Cholesky
+// factorization computed is
+//
+//	A = Uᵀ * U  if ul == blas.Upper
+//	A = L * Lᵀ  if ul == blas.Lower
+//
+// ul also specifies the storage of ab. If ul == blas.Upper, then
+// ab is stored as an upper-triangular banded matrix with kd super-diagonals,
+// and if ul == blas.Lower, ab is stored as a lower-triangular banded matrix
+// with kd sub-diagonals. On exit, the banded matrix U or L is stored in-place
+// into ab depending on the value of ul. Pbtf2 returns whether the factorization
+// was successfully completed.
+//
+// The band storage scheme is illustrated below when n = 6, and kd = 2.
+// The resulting Cholesky decomposition is stored in the same elements as the
+// input band matrix (a11 becomes u11 or l11, etc.).
+//
+//	ul = blas.Upper
+//	a11 a12 a13
+//	a22 a23 a24
+//	a33 a34 a35
+//	a44 a45 a46
+//	a55 a56  *
+//	a66  *   *
+//
+//	ul = blas.Lower
+//	 *   *  a11
+//	 *  a21 a22
+//	a31 a32 a33
+//	a42 a43 a44
+//	a53 a54 a55
+//	a64 a65 a66
+//
+// Pbtf2 is the unblocked version of the algorithm, see Pbtrf for the blocked
+// version.
+//
+// Pbtf2 is an internal routine, exported for testing purposes.
+func Pbtf2(uplo blas.Uplo, n, kd int, ab []float32, ldab int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(lapack.ErrBadUplo)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case kd < 0:
+		panic(lapack.ErrKdLT0)
+	case ldab < kd+1:
+		panic(lapack.ErrBadLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if len(ab) < (n-1)*ldab+kd+1 {
+		panic(lapack.ErrShortAB)
+	}
+
+	kld := max(1, ldab-1)
+	if uplo == blas.Upper {
+		// Compute the Cholesky factorization A = Uᵀ * U.
+		for j := 0; j < n; j++ {
+			// Compute U(j,j) and test for non-positive-definiteness.
+			ajj := ab[j*ldab]
+			if ajj <= 0 {
+				return false
+			}
+			ajj = math.Sqrt(ajj)
+			ab[j*ldab] = ajj
+			// Compute elements j+1:j+kn of row j and update the trailing submatrix
+			// within the band.
+			kn := min(kd, n-j-1)
+			if kn > 0 {
+				blas32.Scal(kn, 1/ajj, ab[j*ldab+1:], 1)
+				blas32.Syr(blas.Upper, kn, -1, ab[j*ldab+1:], 1, ab[(j+1)*ldab:], kld)
+			}
+		}
+		return true
+	}
+	// Compute the Cholesky factorization A = L * Lᵀ.
+	for j := 0; j < n; j++ {
+		// Compute L(j,j) and test for non-positive-definiteness.
+		ajj := ab[j*ldab+kd]
+		if ajj <= 0 {
+			return false
+		}
+		ajj = math.Sqrt(ajj)
+		ab[j*ldab+kd] = ajj
+		// Compute elements j+1:j+kn of column j and update the trailing submatrix
+		// within the band.
+		kn := min(kd, n-j-1)
+		if kn > 0 {
+			blas32.Scal(kn, 1/ajj, ab[(j+1)*ldab+kd-1:], kld)
+			blas32.Syr(blas.Lower, kn, -1, ab[(j+1)*ldab+kd-1:], kld, ab[(j+1)*ldab+kd:], kld)
+		}
+	}
+	return true
+}
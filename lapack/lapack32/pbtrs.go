@@ -0,0 +1,68 @@
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/blas32"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Pbtrs solves a system of linear equations A*X = B with an n×n symmetric
+// positive definite band matrix A using the Cholesky factorization
+//
+//	A = Uᵀ * U  if uplo == blas.Upper
+//	A = L * Lᵀ  if uplo == blas.Lower
+//
+// computed by Pbtrf. kd is the number of super- or sub-diagonals of A. See the
+// documentation for Pbtrf for a description of the band storage format of A.
+//
+// On entry, b contains the n×nrhs right hand side matrix B. On return, it is
+// overwritten with the solution matrix X.
+func Pbtrs(uplo blas.Uplo, n, kd, nrhs int, ab []float32, ldab int, b []float32, ldb int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(lapack.ErrBadUplo)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case kd < 0:
+		panic(lapack.ErrKdLT0)
+	case nrhs < 0:
+		panic(lapack.ErrNrhsLT0)
+	case ldab < kd+1:
+		panic(lapack.ErrBadLdA)
+	case ldb < max(1, nrhs):
+		panic(lapack.ErrBadLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	if len(ab) < (n-1)*ldab+kd+1 {
+		panic(lapack.ErrShortAB)
+	}
+	if len(b) < (n-1)*ldb+nrhs {
+		panic(lapack.ErrShortB)
+	}
+	if uplo == blas.Upper {
+		// Solve A*X = B where A = Uᵀ*U.
+		for j := 0; j < nrhs; j++ {
+			// Solve Uᵀ*Y = B, overwriting B with Y.
+			blas32.Tbsv(blas.Upper, blas.Trans, blas.NonUnit, n, kd, ab, ldab, b[j:], ldb)
+			// Solve U*X = Y, overwriting Y with X.
+			blas32.Tbsv(blas.Upper, blas.NoTrans, blas.NonUnit, n, kd, ab, ldab, b[j:], ldb)
+		}
+	} else {
+		// Solve A*X = B where A = L*Lᵀ.
+		for j := 0; j < nrhs; j++ {
+			// Solve L*Y = B, overwriting B with Y.
+			blas32.Tbsv(blas.Lower, blas.NoTrans, blas.NonUnit, n, kd, ab, ldab, b[j:], ldb)
+			// Solve Lᵀ*X = Y, overwriting Y with X.
+			blas32.Tbsv(blas.Lower, blas.Trans, blas.NonUnit, n, kd, ab, ldab, b[j:], ldb)
+		}
+	}
+}
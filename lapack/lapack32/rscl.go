@@ -0,0 +1,62 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	math "github.com/gocnn/gomat/internal/math32"
+
+	"github.com/gocnn/gomat/blas/blas32"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Rscl multiplies the vector x by 1/a being careful to avoid overflow or
+// underflow where possible.
+//
+// Rscl is an internal routine.
+func Rscl(n int, a float32, x []float32, incX int) {
+	switch {
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case incX <= 0:
+		panic(lapack.ErrBadIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	if len(x) < 1+(n-1)*incX {
+		panic(lapack.ErrShortX)
+	}
+
+	cden := a
+	cnum := float32(1)
+	smlnum := lamchS
+	bignum := 1 / smlnum
+	for {
+		cden1 := cden * smlnum
+		cnum1 := cnum / bignum
+		var mul float32
+		var done bool
+		switch {
+		case cnum != 0 && math.Abs(cden1) > math.Abs(cnum):
+			mul = smlnum
+			done = false
+			cden = cden1
+		case math.Abs(cnum1) > math.Abs(cden):
+			mul = bignum
+			done = false
+			cnum = cnum1
+		default:
+			mul = cnum / cden
+			done = true
+		}
+		blas32.Scal(n, mul, x, incX)
+		if done {
+			break
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright ©2020 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/blas32"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Tbtrs solves a triangular system of the form
+//
+//	A * X = B   if trans == blas.NoTrans
+//	Aᵀ * X = B  if trans == blas.Trans or blas.ConjTrans
+//
+// where A is an n×n triangular band matrix with kd super- or subdiagonals, and
+// B is an n×nrhs matrix.
+//
+// Tbtrs returns whether A is non-singular. If A is singular, no solution X is
+// computed.
+func Tbtrs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, n, kd, nrhs int, a []float32, lda int, b []float32, ldb int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(lapack.ErrBadUplo)
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(lapack.ErrBadTrans)
+	case diag != blas.NonUnit && diag != blas.Unit:
+		panic(lapack.ErrBadDiag)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case kd < 0:
+		panic(lapack.ErrKdLT0)
+	case nrhs < 0:
+		panic(lapack.ErrNrhsLT0)
+	case lda < kd+1:
+		panic(lapack.ErrBadLdA)
+	case ldb < max(1, nrhs):
+		panic(lapack.ErrBadLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+kd+1:
+		panic(lapack.ErrShortA)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(lapack.ErrShortB)
+	}
+
+	// Check for singularity.
+	if diag == blas.NonUnit {
+		if uplo == blas.Upper {
+			for i := 0; i < n; i++ {
+				if a[i*lda] == 0 {
+					return false
+				}
+			}
+		} else {
+			for i := 0; i < n; i++ {
+				if a[i*lda+kd] == 0 {
+					return false
+				}
+			}
+		}
+	}
+
+	// Solve A * X = B  or Aᵀ * X = B.
+	for j := 0; j < nrhs; j++ {
+		blas32.Tbsv(uplo, trans, diag, n, kd, a, lda, b[j:], ldb)
+	}
+	return true
+}
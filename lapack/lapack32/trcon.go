@@ -0,0 +1,88 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack32
+
+import (
+	math "github.com/gocnn/gomat/internal/math32"
+
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/blas32"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Trcon estimates the reciprocal of the condition number of a triangular matrix A.
+// The condition number computed may be based on the 1-norm or the ∞-norm.
+//
+// work is a temporary data slice of length at least 3*n and Trcon will panic otherwise.
+//
+// iwork is a temporary data slice of length at least n and Trcon will panic otherwise.
+func Trcon(norm lapack.MatrixNorm, uplo blas.Uplo, diag blas.Diag, n int, a []float32, lda int, work []float32, iwork []int) float32 {
+	switch {
+	case norm != lapack.MaxColumnSum && norm != lapack.MaxRowSum:
+		panic(lapack.ErrBadNorm)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(lapack.ErrBadUplo)
+	case diag != blas.NonUnit && diag != blas.Unit:
+		panic(lapack.ErrBadDiag)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	}
+
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(lapack.ErrShortA)
+	case len(work) < 3*n:
+		panic(lapack.ErrShortWork)
+	case len(iwork) < n:
+		panic(lapack.ErrShortIWork)
+	}
+
+	var rcond float32
+	smlnum := lamchS * float32(n)
+
+	anorm := Lantr(norm, uplo, diag, n, n, a, lda, work)
+
+	if anorm <= 0 {
+		return rcond
+	}
+	var ainvnm float32
+	var normin bool
+	kase1 := 2
+	if norm == lapack.MaxColumnSum {
+		kase1 = 1
+	}
+	var kase int
+	isave := new([3]int)
+	var scale float32
+	for {
+		ainvnm, kase = Lacn2(n, work[n:], work, iwork, ainvnm, kase, isave)
+		if kase == 0 {
+			if ainvnm != 0 {
+				rcond = (1 / anorm) / ainvnm
+			}
+			return rcond
+		}
+		if kase == kase1 {
+			scale = Latrs(uplo, blas.NoTrans, diag, normin, n, a, lda, work, work[2*n:])
+		} else {
+			scale = Latrs(uplo, blas.Trans, diag, normin, n, a, lda, work, work[2*n:])
+		}
+		normin = true
+		if scale != 1 {
+			ix := blas32.Iamax(n, work, 1)
+			xnorm := math.Abs(work[ix])
+			if scale == 0 || scale < xnorm*smlnum {
+				return rcond
+			}
+			Rscl(n, scale, work, 1)
+		}
+	}
+}
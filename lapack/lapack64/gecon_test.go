@@ -0,0 +1,110 @@
+package lapack64
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/blas64"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// normOf returns the 1-norm of the n×n matrix a with row stride lda if norm is
+// lapack.MaxColumnSum and its ∞-norm if norm is lapack.MaxRowSum.
+func normOf(norm lapack.MatrixNorm, n int, a []float64, lda int) float64 {
+	if norm == lapack.MaxRowSum {
+		return oneNorm(n, n, transpose(n, n, a, lda), max(1, n))
+	}
+	return oneNorm(n, n, a, lda)
+}
+
+func TestGecon(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 10, 50, 100} {
+		for _, norm := range []lapack.MatrixNorm{lapack.MaxColumnSum, lapack.MaxRowSum} {
+			lda := n + 2
+			a := randomGeneral(n, n, lda, rnd)
+			// Grade the columns to make the matrix less well conditioned.
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					a[i*lda+j] *= float64(j + 1)
+				}
+			}
+			anorm := normOf(norm, n, a, lda)
+			rcond := 1 / (anorm * normOf(norm, n, inverse(n, a, lda), n))
+			if !Getrf(n, n, a, lda, make([]int, n)) {
+				t.Fatalf("n=%d: unexpected singular matrix", n)
+			}
+			got := Gecon(norm, n, a, lda, anorm, make([]float64, 4*n), make([]int, n))
+			checkRcond(t, fmt.Sprintf("n=%d,norm=%c", n, norm), got, rcond)
+		}
+	}
+	if got := Gecon(lapack.MaxColumnSum, 0, nil, 1, 0, nil, nil); got != 1 {
+		t.Errorf("n=0: got rcond %v, want 1", got)
+	}
+}
+
+func TestTrcon(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 10, 50, 100} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, diag := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				for _, norm := range []lapack.MatrixNorm{lapack.MaxColumnSum, lapack.MaxRowSum} {
+					lda := n + 1
+					a := randomGeneral(n, n, lda, rnd)
+					// Shrink the off-diagonal elements so that the
+					// condition number does not grow exponentially with n.
+					for i := 0; i < n; i++ {
+						for j := 0; j < n; j++ {
+							if i != j {
+								a[i*lda+j] /= float64(n)
+							}
+						}
+						a[i*lda+i] += 2
+					}
+					tri := triangle(uplo, diag == blas.Unit, n, a, lda)
+					rcond := 1 / (normOf(norm, n, tri, n) * normOf(norm, n, inverse(n, tri, n), n))
+					got := Trcon(norm, uplo, diag, n, a, lda, make([]float64, 3*n), make([]int, n))
+					checkRcond(t, fmt.Sprintf("n=%d,uplo=%c,diag=%c,norm=%c", n, uplo, diag, norm), got, rcond)
+				}
+			}
+		}
+	}
+}
+
+func TestLacn2(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 2, 5, 10, 50} {
+		a := randomGeneral(n, n, n, rnd)
+		want := oneNorm(n, n, a, n)
+		v, x := make([]float64, n), make([]float64, n)
+		isgn := make([]int, n)
+		y := make([]float64, n)
+		var (
+			est   float64
+			kase  int
+			isave [3]int
+		)
+		for {
+			est, kase = Lacn2(n, v, x, isgn, est, kase, &isave)
+			if kase == 0 {
+				break
+			}
+			trans := blas.NoTrans
+			if kase == 2 {
+				trans = blas.Trans
+			}
+			blas64.Gemv(trans, n, n, 1, a, n, x, 1, 0, y, 1)
+			copy(x, y)
+		}
+		// The estimate is a lower bound that is exact in most cases.
+		name := fmt.Sprintf("n=%d", n)
+		if est > want*(1+1e-14) {
+			t.Errorf("%s: estimate %v exceeds the 1-norm %v", name, est, want)
+		}
+		if est < want/10 {
+			t.Errorf("%s: estimate %v far below the 1-norm %v", name, est, want)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package lapack64
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// eigenBlocks returns the n×n real block diagonal matrix D, with row stride
+// n, such that A*VR = VR*D for the real right eigenvector matrix VR returned
+// by Geev. A complex conjugate pair a ± i*b occupies the 2×2 block
+//
+//	[ a b]
+//	[-b a].
+func eigenBlocks(n int, wr, wi []float64) []float64 {
+	d := make([]float64, n*n)
+	for j := 0; j < n; j++ {
+		d[j*n+j] = wr[j]
+		if wi[j] > 0 {
+			d[j*n+j+1] = wi[j]
+			d[(j+1)*n+j] = -wi[j]
+			d[(j+1)*n+j+1] = wr[j+1]
+			j++
+		}
+	}
+	return d
+}
+
+// checkEigenvectorNorms reports an error if the eigenvectors stored in the
+// columns of v in the form returned by Geev do not have unit norm.
+func checkEigenvectorNorms(t *testing.T, name string, n int, v []float64, ldv int, wi []float64) {
+	t.Helper()
+	for j := 0; j < n; j++ {
+		k := 1
+		if wi[j] > 0 {
+			k = 2
+		}
+		norm := frobenius(n, k, v[j:], ldv)
+		if math.Abs(norm-1) > float64(n)*1e-14 {
+			t.Errorf("%s: eigenvector %d has norm %v", name, j, norm)
+		}
+		j += k - 1
+	}
+}
+
+func TestGeev(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 5, 10, 50, 100} {
+		lda, ldv := max(1, n)+2, max(1, n)+1
+		a := randomGeneral(n, n, lda, rnd)
+		aCopy := cloneGeneral(n, n, a, lda)
+		wr, wi := make([]float64, n), make([]float64, n)
+		vl := make([]float64, max(1, n*ldv))
+		vr := make([]float64, max(1, n*ldv))
+		work := optimalWork(func(work []float64, lwork int) {
+			Geev(lapack.LeftEVCompute, lapack.RightEVCompute, n, a, lda, wr, wi, vl, ldv, vr, ldv, work, lwork)
+		})
+		name := fmt.Sprintf("n=%d", n)
+
+		// The eigenvalues alone must match those computed with the
+		// eigenvectors.
+		wrNone, wiNone := make([]float64, n), make([]float64, n)
+		if first := Geev(lapack.LeftEVNone, lapack.RightEVNone, n, cloneGeneral(n, n, a, lda), max(1, n), wrNone, wiNone, nil, 1, nil, 1, work, len(work)); first != 0 {
+			t.Errorf("%s: no convergence without eigenvectors", name)
+			continue
+		}
+		if first := Geev(lapack.LeftEVCompute, lapack.RightEVCompute, n, a, lda, wr, wi, vl, ldv, vr, ldv, work, len(work)); first != 0 {
+			t.Errorf("%s: no convergence", name)
+			continue
+		}
+		for j := 0; j < n; j++ {
+			if wi[j] != 0 && (j+1 == n || wi[j] <= 0 || wr[j+1] != wr[j] || wi[j+1] != -wi[j]) {
+				t.Errorf("%s: eigenvalue %d is not the first of a conjugate pair", name, j)
+				break
+			}
+			if wi[j] != 0 {
+				j++
+			}
+		}
+		checkResidual(t, name+": real parts differ with jobs", 1, n, wr, n, wrNone, n, n, 10)
+		checkResidual(t, name+": imaginary parts differ with jobs", 1, n, wi, n, wiNone, n, n, 10)
+		checkEigenvectorNorms(t, name+": VR", n, vr, ldv, wi)
+		checkEigenvectorNorms(t, name+": VL", n, vl, ldv, wi)
+
+		// A*VR = VR*D and Aᵀ*VL = VL*Dᵀ.
+		d := eigenBlocks(n, wr, wi)
+		avr := mul(blas.NoTrans, blas.NoTrans, n, n, n, aCopy, max(1, n), vr, ldv)
+		vrd := mul(blas.NoTrans, blas.NoTrans, n, n, n, vr, ldv, d, max(1, n))
+		checkResidual(t, name+": A*VR != VR*D", n, n, avr, max(1, n), vrd, max(1, n), n, 10)
+		atvl := mul(blas.Trans, blas.NoTrans, n, n, n, aCopy, max(1, n), vl, ldv)
+		vldt := mul(blas.NoTrans, blas.Trans, n, n, n, vl, ldv, d, max(1, n))
+		checkResidual(t, name+": Aᵀ*VL != VL*Dᵀ", n, n, atvl, max(1, n), vldt, max(1, n), n, 10)
+	}
+}
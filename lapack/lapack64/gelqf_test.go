@@ -0,0 +1,89 @@
+package lapack64
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+// explicitLQ returns the n×n matrix Q of an LQ factorization of an m×n matrix
+// computed by Gelqf, with row stride n.
+func explicitLQ(m, n int, a []float64, lda int, tau []float64) []float64 {
+	k := min(m, n)
+	q := make([]float64, n*n)
+	for i := 0; i < k; i++ {
+		copy(q[i*n:i*n+n], a[i*lda:i*lda+n])
+	}
+	ldq := max(1, n)
+	work := optimalWork(func(work []float64, lwork int) {
+		Orglq(n, n, k, q, ldq, tau, work, lwork)
+	})
+	Orglq(n, n, k, q, ldq, tau, work, len(work))
+	return q
+}
+
+func TestGelqf(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range qrSizes {
+		m, n := dims[0], dims[1]
+		lda := max(1, n) + 2
+		a := randomGeneral(m, n, lda, rnd)
+		aCopy := cloneGeneral(m, n, a, lda)
+		tau := make([]float64, min(m, n))
+		work := optimalWork(func(work []float64, lwork int) {
+			Gelqf(m, n, a, lda, tau, work, lwork)
+		})
+		Gelqf(m, n, a, lda, tau, work, len(work))
+
+		name := fmt.Sprintf("m=%d,n=%d", m, n)
+		q := explicitLQ(m, n, a, lda, tau)
+		checkOrthogonal(t, name, n, n, q, max(1, n), true)
+		k := max(m, n)
+		l := triangle(blas.Lower, false, k, padded(m, n, a, lda), k)
+		lq := mul(blas.NoTrans, blas.NoTrans, m, n, n, l, max(1, k), q, max(1, n))
+		checkResidual(t, name+": A != L*Q", m, n, aCopy, max(1, n), lq, max(1, n), max(m, n), 10)
+	}
+}
+
+func TestOrmlq(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range qrSizes {
+		m, n := dims[0], dims[1]
+		if m == 0 || m > n {
+			continue
+		}
+		lda := n + 1
+		a := randomGeneral(m, n, lda, rnd)
+		tau := make([]float64, m)
+		work := optimalWork(func(work []float64, lwork int) {
+			Gelqf(m, n, a, lda, tau, work, lwork)
+		})
+		Gelqf(m, n, a, lda, tau, work, len(work))
+		q := explicitLQ(m, n, a, lda, tau)
+
+		for _, side := range []blas.Side{blas.Left, blas.Right} {
+			for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				cm, cn := n, 7
+				if side == blas.Right {
+					cm, cn = 7, n
+				}
+				ldc := cn + 3
+				c := randomGeneral(cm, cn, ldc, rnd)
+				var want []float64
+				if side == blas.Left {
+					want = mul(trans, blas.NoTrans, cm, cn, n, q, n, c, ldc)
+				} else {
+					want = mul(blas.NoTrans, trans, cm, cn, n, c, ldc, q, n)
+				}
+				work := optimalWork(func(work []float64, lwork int) {
+					Ormlq(side, trans, cm, cn, m, a, lda, tau, c, ldc, work, lwork)
+				})
+				Ormlq(side, trans, cm, cn, m, a, lda, tau, c, ldc, work, len(work))
+				name := fmt.Sprintf("m=%d,n=%d,side=%c,trans=%c", m, n, side, trans)
+				checkResidual(t, name, cm, cn, want, max(1, cn), c, ldc, n, 10)
+			}
+		}
+	}
+}
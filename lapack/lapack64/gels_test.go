@@ -0,0 +1,108 @@
+package lapack64
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+// transpose returns the transpose of the m×n matrix a with row stride lda,
+// with row stride m.
+func transpose(m, n int, a []float64, lda int) []float64 {
+	b := make([]float64, n*m)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			b[j*m+i] = a[i*lda+j]
+		}
+	}
+	return b
+}
+
+func TestGels(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{
+		{0, 0}, {1, 1}, {10, 5}, {5, 10}, {10, 10},
+		{150, 100}, {100, 150},
+	} {
+		m, n := dims[0], dims[1]
+		for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, nrhs := range []int{1, 4} {
+				lda, ldb := max(1, n)+2, nrhs+1
+				a := randomGeneral(m, n, lda, rnd)
+				// op(A) is the p×q matrix A or Aᵀ with row stride q.
+				p, q := m, n
+				opA := cloneGeneral(m, n, a, lda)
+				if trans == blas.Trans {
+					p, q = n, m
+					opA = transpose(m, n, a, lda)
+				}
+				b := randomGeneral(max(m, n), nrhs, ldb, rnd)
+				bCopy := cloneGeneral(p, nrhs, b, ldb)
+
+				work := optimalWork(func(work []float64, lwork int) {
+					Gels(trans, m, n, nrhs, a, lda, b, ldb, work, lwork)
+				})
+				// The quick return for an empty A reports an optimal
+				// length below the minimum.
+				if mn := min(m, n); len(work) < mn+max(mn, nrhs) {
+					work = make([]float64, mn+max(mn, nrhs))
+				}
+				name := fmt.Sprintf("m=%d,n=%d,nrhs=%d,trans=%c", m, n, nrhs, trans)
+				if !Gels(trans, m, n, nrhs, a, lda, b, ldb, work, len(work)) {
+					t.Errorf("%s: unexpected rank deficiency", name)
+					continue
+				}
+				if p == 0 || q == 0 {
+					continue
+				}
+
+				ldq := max(1, q)
+				if p >= q {
+					// The least-squares solution satisfies the normal
+					// equations op(A)ᵀ*op(A)*X = op(A)ᵀ*B.
+					ata := mul(blas.Trans, blas.NoTrans, q, q, p, opA, ldq, opA, ldq)
+					lhs := mul(blas.NoTrans, blas.NoTrans, q, nrhs, q, ata, ldq, b, ldb)
+					rhs := mul(blas.Trans, blas.NoTrans, q, nrhs, p, opA, ldq, bCopy, nrhs)
+					checkResidual(t, name+": normal equations", q, nrhs, rhs, nrhs, lhs, nrhs, p, 1000)
+					continue
+				}
+				// The minimum-norm solution is op(A)ᵀ*(op(A)*op(A)ᵀ)⁻¹*B.
+				aat := mul(blas.NoTrans, blas.Trans, p, p, q, opA, ldq, opA, ldq)
+				y := mul(blas.NoTrans, blas.NoTrans, p, nrhs, p, inverse(p, aat, p), p, bCopy, nrhs)
+				want := mul(blas.Trans, blas.NoTrans, q, nrhs, p, opA, ldq, y, nrhs)
+				checkResidual(t, name+": not the minimum-norm solution", q, nrhs, want, nrhs, b, ldb, q, 1000)
+			}
+		}
+	}
+}
+
+func TestGelsRankDeficient(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{{10, 5}, {5, 10}, {10, 10}, {150, 100}, {100, 150}} {
+		m, n := dims[0], dims[1]
+		for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			const nrhs = 2
+			a := randomGeneral(m, n, n, rnd)
+			// A zero column of a tall A or a zero row of a wide A gives
+			// an exactly zero diagonal element in the triangular factor.
+			if m >= n {
+				for i := 0; i < m; i++ {
+					a[i*n+n/2] = 0
+				}
+			} else {
+				for j := 0; j < n; j++ {
+					a[m/2*n+j] = 0
+				}
+			}
+			b := randomGeneral(max(m, n), nrhs, nrhs, rnd)
+			work := optimalWork(func(work []float64, lwork int) {
+				Gels(trans, m, n, nrhs, a, n, b, nrhs, work, lwork)
+			})
+			if Gels(trans, m, n, nrhs, a, n, b, nrhs, work, len(work)) {
+				t.Errorf("m=%d,n=%d,trans=%c: rank deficiency not detected", m, n, trans)
+			}
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package lapack64
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/blas64"
+)
+
+// randomGeneral returns an m×n matrix with elements uniform in [-1, 1) stored
+// with row stride lda. The elements in the padding columns are set to NaN so
+// that routines that read them are caught.
+func randomGeneral(m, n, lda int, rnd *rand.Rand) []float64 {
+	a := make([]float64, max(0, (m-1)*lda+n))
+	for i := range a {
+		a[i] = math.NaN()
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			a[i*lda+j] = 2*rnd.Float64() - 1
+		}
+	}
+	return a
+}
+
+// randomSymmetric returns a random n×n symmetric matrix with both triangles
+// filled, stored with row stride lda.
+func randomSymmetric(n, lda int, rnd *rand.Rand) []float64 {
+	a := randomGeneral(n, n, lda, rnd)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			a[i*lda+j] = a[j*lda+i]
+		}
+	}
+	return a
+}
+
+// randomSPD returns a random n×n symmetric positive definite matrix with both
+// triangles filled, stored with row stride lda.
+func randomSPD(n, lda int, rnd *rand.Rand) []float64 {
+	b := randomGeneral(n, n, n, rnd)
+	a := randomGeneral(n, n, lda, rnd)
+	blas64.Gemm(blas.NoTrans, blas.Trans, n, n, n, 1, b, max(1, n), b, max(1, n), 0, a, lda)
+	for i := 0; i < n; i++ {
+		a[i*lda+i] += float64(n)
+	}
+	return a
+}
+
+// eye returns the n×n identity matrix with row stride lda.
+func eye(n, lda int) []float64 {
+	a := make([]float64, max(0, (n-1)*lda+n))
+	for i := 0; i < n; i++ {
+		a[i*lda+i] = 1
+	}
+	return a
+}
+
+// cloneGeneral returns a copy of the m×n matrix a with row stride lda,
+// stored with row stride n.
+func cloneGeneral(m, n int, a []float64, lda int) []float64 {
+	b := make([]float64, m*n)
+	for i := 0; i < m; i++ {
+		copy(b[i*n:i*n+n], a[i*lda:i*lda+n])
+	}
+	return b
+}
+
+// mul returns the m×n product op(A)*op(B) with row stride n, where op(A) is
+// m×k and op(B) is k×n.
+func mul(tA, tB blas.Transpose, m, n, k int, a []float64, lda int, b []float64, ldb int) []float64 {
+	c := make([]float64, m*n)
+	if m == 0 || n == 0 {
+		return c
+	}
+	blas64.Gemm(tA, tB, m, n, k, 1, a, lda, b, ldb, 0, c, max(1, n))
+	return c
+}
+
+// frobenius returns the Frobenius norm of the m×n matrix a with row stride
+// lda.
+func frobenius(m, n int, a []float64, lda int) float64 {
+	var sum float64
+	for i := 0; i < m; i++ {
+		for _, v := range a[i*lda : i*lda+n] {
+			sum += v * v
+		}
+	}
+	return math.Sqrt(sum)
+}
+
+// distFrobenius returns the Frobenius norm of A - B for m×n matrices a and b
+// with row strides lda and ldb.
+func distFrobenius(m, n int, a []float64, lda int, b []float64, ldb int) float64 {
+	var sum float64
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			d := a[i*lda+j] - b[i*ldb+j]
+			sum += d * d
+		}
+	}
+	return math.Sqrt(sum)
+}
+
+// checkResidual reports an error if the Frobenius norm of A - B is larger than
+// tol times the size of the problem, the machine epsilon and the norm of A.
+func checkResidual(t *testing.T, name string, m, n int, a []float64, lda int, b []float64, ldb int, size int, tol float64) {
+	t.Helper()
+	anorm := max(frobenius(m, n, a, lda), 1)
+	resid := distFrobenius(m, n, a, lda, b, ldb) / (anorm * float64(max(size, 1)) * lamchE)
+	if !(resid <= tol) {
+		t.Errorf("%s: residual %v too large", name, resid)
+	}
+}
+
+// checkOrthogonal reports an error if the m×n matrix Q with row stride ldq does
+// not have orthonormal columns, or rows if rows is true.
+func checkOrthogonal(t *testing.T, name string, m, n int, q []float64, ldq int, rows bool) {
+	t.Helper()
+	var qtq []float64
+	var k int
+	if rows {
+		k = m
+		qtq = mul(blas.NoTrans, blas.Trans, m, m, n, q, ldq, q, ldq)
+	} else {
+		k = n
+		qtq = mul(blas.Trans, blas.NoTrans, n, n, m, q, ldq, q, ldq)
+	}
+	checkResidual(t, name+": not orthogonal", k, k, eye(k, max(1, k)), max(1, k), qtq, max(1, k), max(m, n), 10)
+}
+
+// oneNorm returns the maximum column sum of the m×n matrix a with row stride
+// lda.
+func oneNorm(m, n int, a []float64, lda int) float64 {
+	var norm float64
+	for j := 0; j < n; j++ {
+		var sum float64
+		for i := 0; i < m; i++ {
+			sum += math.Abs(a[i*lda+j])
+		}
+		norm = max(norm, sum)
+	}
+	return norm
+}
+
+// triangle returns the uplo triangle of the n×n matrix a with row stride lda
+// as a dense matrix with row stride n. If unit is true, the diagonal is taken
+// to be one.
+func triangle(uplo blas.Uplo, unit bool, n int, a []float64, lda int) []float64 {
+	t := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if (uplo == blas.Upper && j >= i) || (uplo == blas.Lower && j <= i) {
+				t[i*n+j] = a[i*lda+j]
+			}
+		}
+		if unit {
+			t[i*n+i] = 1
+		}
+	}
+	return t
+}
+
+// symmetric returns the n×n symmetric matrix whose uplo triangle is stored in
+// a with row stride lda, as a dense matrix with row stride n.
+func symmetric(uplo blas.Uplo, n int, a []float64, lda int) []float64 {
+	s := triangle(uplo, false, n, a, lda)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			if uplo == blas.Upper {
+				s[i*n+j] = s[j*n+i]
+			} else {
+				s[j*n+i] = s[i*n+j]
+			}
+		}
+	}
+	return s
+}
+
+// inverse returns the inverse of the n×n matrix a with row stride lda, with
+// row stride n.
+func inverse(n int, a []float64, lda int) []float64 {
+	ainv := cloneGeneral(n, n, a, lda)
+	ipiv := make([]int, n)
+	if !Getrf(n, n, ainv, max(1, n), ipiv) {
+		panic("singular matrix")
+	}
+	work := make([]float64, max(1, 4*n))
+	Getri(n, ainv, max(1, n), ipiv, work, len(work))
+	return ainv
+}
+
+// checkRcond reports an error if the estimate of the reciprocal condition
+// number is not within a factor of ten of the value rcond.
+func checkRcond(t *testing.T, name string, got, rcond float64) {
+	t.Helper()
+	if !(got >= rcond/10 && got <= 10*rcond) {
+		t.Errorf("%s: rcond estimate %v, want about %v", name, got, rcond)
+	}
+}
+
+// optimalWork returns a work slice of the optimal length reported by the
+// workspace query of f, which must call a routine with the given work and
+// lwork.
+func optimalWork(f func(work []float64, lwork int)) []float64 {
+	work := make([]float64, 1)
+	f(work, -1)
+	return make([]float64, max(1, int(work[0])))
+}
+
+// padded returns the m×n matrix a with row stride lda embedded in the top left
+// corner of a max(m,n)×max(m,n) zero matrix.
+func padded(m, n int, a []float64, lda int) []float64 {
+	k := max(m, n)
+	p := make([]float64, k*k)
+	for i := 0; i < m; i++ {
+		copy(p[i*k:i*k+n], a[i*lda:i*lda+n])
+	}
+	return p
+}
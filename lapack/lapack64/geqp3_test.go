@@ -0,0 +1,125 @@
+package lapack64
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+func TestGeqp3(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range qrSizes {
+		m, n := dims[0], dims[1]
+		for _, fixed := range []bool{false, true} {
+			lda := max(1, n) + 2
+			a := randomGeneral(m, n, lda, rnd)
+			aCopy := cloneGeneral(m, n, a, lda)
+			jpvt := make([]int, n)
+			var nfixed int
+			for j := range jpvt {
+				jpvt[j] = -1
+				// Fix every third column to the front of A*P.
+				if fixed && j%3 == 1 {
+					jpvt[j] = 0
+					nfixed++
+				}
+			}
+			tau := make([]float64, min(m, n))
+			work := optimalWork(func(work []float64, lwork int) {
+				Geqp3(m, n, a, lda, jpvt, tau, work, lwork)
+			})
+			Geqp3(m, n, a, lda, jpvt, tau, work, len(work))
+
+			name := fmt.Sprintf("m=%d,n=%d,fixed=%t", m, n, fixed)
+			if !isPermutation(jpvt) {
+				t.Errorf("%s: jpvt %v is not a permutation", name, jpvt)
+				continue
+			}
+			for j := 0; j < nfixed; j++ {
+				if jpvt[j] != 3*j+1 {
+					t.Errorf("%s: column %d of A*P is column %d of A, want %d", name, j, jpvt[j], 3*j+1)
+				}
+			}
+			// The free columns are pivoted so that the diagonal of R is
+			// non-increasing in magnitude.
+			for i := nfixed + 1; i < min(m, n); i++ {
+				if math.Abs(a[i*lda+i]) > math.Abs(a[(i-1)*lda+i-1])*(1+1e-12) {
+					t.Errorf("%s: |R[%d,%d]| increases", name, i, i)
+					break
+				}
+			}
+
+			q := explicitQ(m, n, a, lda, tau)
+			checkOrthogonal(t, name, m, m, q, max(1, m), false)
+			r := triangle(blas.Upper, false, max(m, n), padded(m, n, a, lda), max(m, n))
+			qr := mul(blas.NoTrans, blas.NoTrans, m, n, m, q, max(1, m), r, max(m, n, 1))
+			Lapmt(true, m, n, aCopy, max(1, n), jpvt)
+			checkResidual(t, name+": A*P != Q*R", m, n, aCopy, max(1, n), qr, max(1, n), max(m, n), 10)
+		}
+	}
+}
+
+// isPermutation reports whether p is a permutation of 0, ..., len(p)-1.
+func isPermutation(p []int) bool {
+	s := slices.Sorted(slices.Values(p))
+	for i, v := range s {
+		if v != i {
+			return false
+		}
+	}
+	return true
+}
+
+func TestLapmt(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{{0, 0}, {1, 1}, {3, 5}, {5, 3}, {4, 10}} {
+		m, n := dims[0], dims[1]
+		ldx := max(1, n) + 1
+		x := randomGeneral(m, n, ldx, rnd)
+		xCopy := cloneGeneral(m, n, x, ldx)
+		k := rnd.Perm(n)
+		name := fmt.Sprintf("m=%d,n=%d", m, n)
+
+		Lapmt(true, m, n, x, ldx, slices.Clone(k))
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				if x[i*ldx+j] != xCopy[i*n+k[j]] {
+					t.Errorf("%s: forward permutation: X[%d,%d] is wrong", name, i, j)
+				}
+			}
+		}
+		Lapmt(false, m, n, x, ldx, slices.Clone(k))
+		if distFrobenius(m, n, x, ldx, xCopy, max(1, n)) != 0 {
+			t.Errorf("%s: backward permutation does not undo the forward one", name)
+		}
+	}
+}
+
+func TestLapmr(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{{0, 0}, {1, 1}, {3, 5}, {5, 3}, {10, 4}} {
+		m, n := dims[0], dims[1]
+		ldx := max(1, n) + 1
+		x := randomGeneral(m, n, ldx, rnd)
+		xCopy := cloneGeneral(m, n, x, ldx)
+		k := rnd.Perm(m)
+		name := fmt.Sprintf("m=%d,n=%d", m, n)
+
+		Lapmr(true, m, n, x, ldx, slices.Clone(k))
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				if x[i*ldx+j] != xCopy[k[i]*n+j] {
+					t.Errorf("%s: forward permutation: X[%d,%d] is wrong", name, i, j)
+				}
+			}
+		}
+		Lapmr(false, m, n, x, ldx, slices.Clone(k))
+		if distFrobenius(m, n, x, ldx, xCopy, max(1, n)) != 0 {
+			t.Errorf("%s: backward permutation does not undo the forward one", name)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package lapack64
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+// qrSizes are the matrix sizes of the QR and LQ tests. The large ones use the
+// blocked code.
+var qrSizes = [][2]int{
+	{0, 0}, {1, 1}, {5, 3}, {3, 5}, {10, 10},
+	{150, 100}, {100, 150}, {160, 160},
+}
+
+// explicitQ returns the m×m matrix Q of a QR factorization of an m×n matrix
+// computed by Geqrf, with row stride m.
+func explicitQ(m, n int, a []float64, lda int, tau []float64) []float64 {
+	k := min(m, n)
+	q := make([]float64, m*m)
+	for i := 0; i < m; i++ {
+		copy(q[i*m:i*m+k], a[i*lda:i*lda+k])
+	}
+	ldq := max(1, m)
+	work := optimalWork(func(work []float64, lwork int) {
+		Orgqr(m, m, k, q, ldq, tau, work, lwork)
+	})
+	Orgqr(m, m, k, q, ldq, tau, work, len(work))
+	return q
+}
+
+func TestGeqrf(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range qrSizes {
+		m, n := dims[0], dims[1]
+		lda := max(1, n) + 2
+		a := randomGeneral(m, n, lda, rnd)
+		aCopy := cloneGeneral(m, n, a, lda)
+		tau := make([]float64, min(m, n))
+		work := optimalWork(func(work []float64, lwork int) {
+			Geqrf(m, n, a, lda, tau, work, lwork)
+		})
+		Geqrf(m, n, a, lda, tau, work, len(work))
+
+		name := fmt.Sprintf("m=%d,n=%d", m, n)
+		q := explicitQ(m, n, a, lda, tau)
+		checkOrthogonal(t, name, m, m, q, max(1, m), false)
+		r := triangle(blas.Upper, false, max(m, n), padded(m, n, a, lda), max(m, n))
+		qr := mul(blas.NoTrans, blas.NoTrans, m, n, m, q, max(1, m), r, max(m, n, 1))
+		checkResidual(t, name+": A != Q*R", m, n, aCopy, max(1, n), qr, max(1, n), max(m, n), 10)
+	}
+}
+
+func TestOrmqr(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range qrSizes {
+		m, n := dims[0], dims[1]
+		if n == 0 || n > m {
+			continue
+		}
+		lda := max(1, n) + 1
+		a := randomGeneral(m, n, lda, rnd)
+		tau := make([]float64, n)
+		work := optimalWork(func(work []float64, lwork int) {
+			Geqrf(m, n, a, lda, tau, work, lwork)
+		})
+		Geqrf(m, n, a, lda, tau, work, len(work))
+		q := explicitQ(m, n, a, lda, tau)
+
+		for _, side := range []blas.Side{blas.Left, blas.Right} {
+			for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				cm, cn := m, 7
+				if side == blas.Right {
+					cm, cn = 7, m
+				}
+				ldc := cn + 3
+				c := randomGeneral(cm, cn, ldc, rnd)
+				var want []float64
+				if side == blas.Left {
+					want = mul(trans, blas.NoTrans, cm, cn, m, q, max(1, m), c, ldc)
+				} else {
+					want = mul(blas.NoTrans, trans, cm, cn, m, c, ldc, q, max(1, m))
+				}
+				work := optimalWork(func(work []float64, lwork int) {
+					Ormqr(side, trans, cm, cn, n, a, lda, tau, c, ldc, work, lwork)
+				})
+				Ormqr(side, trans, cm, cn, n, a, lda, tau, c, ldc, work, len(work))
+				name := fmt.Sprintf("m=%d,n=%d,side=%c,trans=%c", m, n, side, trans)
+				checkResidual(t, name, cm, cn, want, max(1, cn), c, ldc, m, 10)
+			}
+		}
+	}
+}
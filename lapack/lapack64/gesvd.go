@@ -0,0 +1,2140 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack64
+
+import (
+	"math"
+
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/blas64"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Gesvd computes the singular value decomposition of the input matrix A.
+//
+// The singular value decomposition is
+//
+//	A = U * Sigma * Vᵀ
+//
+// where Sigma is an m×n diagonal matrix containing the singular values of A,
+// U is an m×m orthogonal matrix and V is an n×n orthogonal matrix. The first
+// min(m,n) columns of U and V are the left and right singular vectors of A
+// respectively.
+//
+// jobU and jobVT are options for computing the singular vectors. The behavior
+// is as follows
+//
+//	jobU == lapack.SVDAll       All m columns of U are returned in u
+//	jobU == lapack.SVDStore     The first min(m,n) columns are returned in u
+//	jobU == lapack.SVDOverwrite The first min(m,n) columns of U are written into a
+//	jobU == lapack.SVDNone      The columns of U are not computed.
+//
+// The behavior is the same for jobVT and the rows of Vᵀ. Only one of jobU
+// and jobVT can equal lapack.SVDOverwrite, and Gesvd panics with
+// lapack.ErrBothSVDOver otherwise.
+//
+// On entry, a contains the data for the m×n matrix A. During the call to Gesvd
+// the data is overwritten. On exit, A contains the appropriate singular vectors
+// if either job is lapack.SVDOverwrite.
+//
+// s is a slice of length at least min(m,n) and on exit contains the singular
+// values in decreasing order.
+//
+// u contains the left singular vectors on exit, stored column-wise. If
+// jobU == lapack.SVDAll, u is of size m×m. If jobU == lapack.SVDStore u is
+// of size m×min(m,n). If jobU == lapack.SVDOverwrite or lapack.SVDNone, u is
+// not used.
+//
+// vt contains the left singular vectors on exit, stored row-wise. If
+// jobV == lapack.SVDAll, vt is of size n×n. If jobVT == lapack.SVDStore vt is
+// of size min(m,n)×n. If jobVT == lapack.SVDOverwrite or lapack.SVDNone, vt is
+// not used.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. lwork must be at least max(5*min(m,n), 3*min(m,n)+max(m,n)).
+// If lwork == -1, instead of performing Gesvd, the optimal work length will be
+// stored into work[0]. Gesvd will panic if the working memory has insufficient
+// storage.
+//
+// Gesvd returns whether the decomposition successfully completed.
+func Gesvd(jobU, jobVT lapack.SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int) (ok bool) {
+	wantua := jobU == lapack.SVDAll
+	wantus := jobU == lapack.SVDStore
+	wantuas := wantua || wantus
+	wantuo := jobU == lapack.SVDOverwrite
+	wantun := jobU == lapack.SVDNone
+	if !(wantua || wantus || wantuo || wantun) {
+		panic(lapack.ErrBadSVDJob)
+	}
+
+	wantva := jobVT == lapack.SVDAll
+	wantvs := jobVT == lapack.SVDStore
+	wantvas := wantva || wantvs
+	wantvo := jobVT == lapack.SVDOverwrite
+	wantvn := jobVT == lapack.SVDNone
+	if !(wantva || wantvs || wantvo || wantvn) {
+		panic(lapack.ErrBadSVDJob)
+	}
+
+	if wantuo && wantvo {
+		panic(lapack.ErrBothSVDOver)
+	}
+
+	minmn := min(m, n)
+	minwork := 1
+	if minmn > 0 {
+		minwork = max(3*minmn+max(m, n), 5*minmn)
+	}
+	switch {
+	case m < 0:
+		panic(lapack.ErrMLT0)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	case ldu < 1, wantua && ldu < m, wantus && ldu < minmn:
+		panic(lapack.ErrBadLdU)
+	case ldvt < 1 || (wantvas && ldvt < n):
+		panic(lapack.ErrBadLdVT)
+	case lwork < minwork && lwork != -1:
+		panic(lapack.ErrBadLWork)
+	case len(work) < max(1, lwork):
+		panic(lapack.ErrShortWork)
+	}
+
+	// Quick return if possible.
+	if minmn == 0 {
+		work[0] = 1
+		return true
+	}
+
+	// Compute optimal workspace size for subroutines.
+	opts := string(jobU) + string(jobVT)
+	mnthr := Ilaenv(6, "DGESVD", opts, m, n, 0, 0)
+	maxwrk := 1
+	var wrkbl, bdspac int
+	if m >= n {
+		bdspac = 5 * n
+		Geqrf(m, n, a, lda, nil, work, -1)
+		lwork_dgeqrf := int(work[0])
+
+		Orgqr(m, n, n, a, lda, nil, work, -1)
+		lwork_dorgqr_n := int(work[0])
+		Orgqr(m, m, n, a, lda, nil, work, -1)
+		lwork_dorgqr_m := int(work[0])
+
+		Gebrd(n, n, a, lda, s, nil, nil, nil, work, -1)
+		lwork_dgebrd := int(work[0])
+
+		Orgbr(lapack.GeneratePT, n, n, n, a, lda, nil, work, -1)
+		lwork_dorgbr_p := int(work[0])
+
+		Orgbr(lapack.GenerateQ, n, n, n, a, lda, nil, work, -1)
+		lwork_dorgbr_q := int(work[0])
+
+		if m >= mnthr {
+			if wantun {
+				// Path 1 (m much larger than n, jobU == None)
+				maxwrk = n + lwork_dgeqrf
+				maxwrk = max(maxwrk, 3*n+lwork_dgebrd)
+				if wantvo || wantvas {
+					maxwrk = max(maxwrk, 3*n+lwork_dorgbr_p)
+				}
+				maxwrk = max(maxwrk, bdspac)
+			} else if wantuo && wantvn {
+				// Path 2 (m much larger than n, jobU == Overwrite, jobVT == None)
+				wrkbl = n + lwork_dgeqrf
+				wrkbl = max(wrkbl, n+lwork_dorgqr_n)
+				wrkbl = max(wrkbl, 3*n+lwork_dgebrd)
+				wrkbl = max(wrkbl, 3*n+lwork_dorgbr_q)
+				wrkbl = max(wrkbl, bdspac)
+				maxwrk = max(n*n+wrkbl, n*n+m*n+n)
+			} else if wantuo && wantvas {
+				// Path 3 (m much larger than n, jobU == Overwrite, jobVT == Store or All)
+				wrkbl = n + lwork_dgeqrf
+				wrkbl = max(wrkbl, n+lwork_dorgqr_n)
+				wrkbl = max(wrkbl, 3*n+lwork_dgebrd)
+				wrkbl = max(wrkbl, 3*n+lwork_dorgbr_q)
+				wrkbl = max(wrkbl, 3*n+lwork_dorgbr_p)
+				wrkbl = max(wrkbl, bdspac)
+				maxwrk = max(n*n+wrkbl, n*n+m*n+n)
+			} else if wantus && wantvn {
+				// Path 4 (m much larger than n, jobU == Store, jobVT == None)
+				wrkbl = n + lwork_dgeqrf
+				wrkbl = max(wrkbl, n+lwork_dorgqr_n)
+				wrkbl = max(wrkbl, 3*n+lwork_dgebrd)
+				wrkbl = max(wrkbl, 3*n+lwork_dorgbr_q)
+				wrkbl = max(wrkbl, bdspac)
+				maxwrk = n*n + wrkbl
+			} else if wantus && wantvo {
+				// Path 5 (m much larger than n, jobU == Store, jobVT == Overwrite)
+				wrkbl = n + lwork_dgeqrf
+				wrkbl = max(wrkbl, n+lwork_dorgqr_n)
+				wrkbl = max(wrkbl, 3*n+lwork_dgebrd)
+				wrkbl = max(wrkbl, 3*n+lwork_dorgbr_q)
+				wrkbl = max(wrkbl, 3*n+lwork_dorgbr_p)
+				wrkbl = max(wrkbl, bdspac)
+				maxwrk = 2*n*n + wrkbl
+			} else if wantus && wantvas {
+				// Path 6 (m much larger than n, jobU == Store, jobVT == Store or All)
+				wrkbl = n + lwork_dgeqrf
+				wrkbl = max(wrkbl, n+lwork_dorgqr_n)
+				wrkbl = max(wrkbl, 3*n+lwork_dgebrd)
+				wrkbl = max(wrkbl, 3*n+lwork_dorgbr_q)
+				wrkbl = max(wrkbl, 3*n+lwork_dorgbr_p)
+				wrkbl = max(wrkbl, bdspac)
+				maxwrk = n*n + wrkbl
+			} else if wantua && wantvn {
+				// Path 7 (m much larger than n, jobU == All, jobVT == None)
+				wrkbl = n + lwork_dgeqrf
+				wrkbl = max(wrkbl, n+lwork_dorgqr_m)
+				wrkbl = max(wrkbl, 3*n+lwork_dgebrd)
+				wrkbl = max(wrkbl, 3*n+lwork_dorgbr_q)
+				wrkbl = max(wrkbl, bdspac)
+				maxwrk = n*n + wrkbl
+			} else if wantua && wantvo {
+				// Path 8 (m much larger than n, jobU == All, jobVT == Overwrite)
+				wrkbl = n + lwork_dgeqrf
+				wrkbl = max(wrkbl, n+lwork_dorgqr_m)
+				wrkbl = max(wrkbl, 3*n+lwork_dgebrd)
+				wrkbl = max(wrkbl, 3*n+lwork_dorgbr_q)
+				wrkbl = max(wrkbl, 3*n+lwork_dorgbr_p)
+				wrkbl = max(wrkbl, bdspac)
+				maxwrk = 2*n*n + wrkbl
+			} else if wantua && wantvas {
+				// Path 9 (m much larger than n, jobU == All, jobVT == Store or All)
+				wrkbl = n + lwork_dgeqrf
+				wrkbl = max(wrkbl, n+lwork_dorgqr_m)
+				wrkbl = max(wrkbl, 3*n+lwork_dgebrd)
+				wrkbl = max(wrkbl, 3*n+lwork_dorgbr_q)
+				wrkbl = max(wrkbl, 3*n+lwork_dorgbr_p)
+				wrkbl = max(wrkbl, bdspac)
+				maxwrk = n*n + wrkbl
+			}
+		} else {
+			// Path 10 (m at least n, but not much larger)
+			Gebrd(m, n, a, lda, s, nil, nil, nil, work, -1)
+			lwork_dgebrd := int(work[0])
+			maxwrk = 3*n + lwork_dgebrd
+			if wantus || wantuo {
+				Orgbr(lapack.GenerateQ, m, n, n, a, lda, nil, work, -1)
+				lwork_dorgbr_q = int(work[0])
+				maxwrk = max(maxwrk, 3*n+lwork_dorgbr_q)
+			}
+			if wantua {
+				Orgbr(lapack.GenerateQ, m, m, n, a, lda, nil, work, -1)
+				lwork_dorgbr_q := int(work[0])
+				maxwrk = max(maxwrk, 3*n+lwork_dorgbr_q)
+			}
+			if !wantvn {
+				maxwrk = max(maxwrk, 3*n+lwork_dorgbr_p)
+			}
+			maxwrk = max(maxwrk, bdspac)
+		}
+	} else {
+		bdspac = 5 * m
+
+		Gelqf(m, n, a, lda, nil, work, -1)
+		lwork_dgelqf := int(work[0])
+
+		Orglq(n, n, m, nil, n, nil, work, -1)
+		lwork_dorglq_n := int(work[0])
+		Orglq(m, n, m, a, lda, nil, work, -1)
+		lwork_dorglq_m := int(work[0])
+
+		Gebrd(m, m, a, lda, s, nil, nil, nil, work, -1)
+		lwork_dgebrd := int(work[0])
+
+		Orgbr(lapack.GeneratePT, m, m, m, a, n, nil, work, -1)
+		lwork_dorgbr_p := int(work[0])
+
+		Orgbr(lapack.GenerateQ, m, m, m, a, n, nil, work, -1)
+		lwork_dorgbr_q := int(work[0])
+
+		if n >= mnthr {
+			if wantvn {
+				// Path 1t (n much larger than m, jobVT == None)
+				maxwrk = m + lwork_dgelqf
+				maxwrk = max(maxwrk, 3*m+lwork_dgebrd)
+				if wantuo || wantuas {
+					maxwrk = max(maxwrk, 3*m+lwork_dorgbr_q)
+				}
+				maxwrk = max(maxwrk, bdspac)
+			} else if wantvo && wantun {
+				// Path 2t (n much larger than m, jobU == None, jobVT == Overwrite)
+				wrkbl = m + lwork_dgelqf
+				wrkbl = max(wrkbl, m+lwork_dorglq_m)
+				wrkbl = max(wrkbl, 3*m+lwork_dgebrd)
+				wrkbl = max(wrkbl, 3*m+lwork_dorgbr_p)
+				wrkbl = max(wrkbl, bdspac)
+				maxwrk = max(m*m+wrkbl, m*m+m*n+m)
+			} else if wantvo && wantuas {
+				// Path 3t (n much larger than m, jobU == Store or All, jobVT == Overwrite)
+				wrkbl = m + lwork_dgelqf
+				wrkbl = max(wrkbl, m+lwork_dorglq_m)
+				wrkbl = max(wrkbl, 3*m+lwork_dgebrd)
+				wrkbl = max(wrkbl, 3*m+lwork_dorgbr_p)
+				wrkbl = max(wrkbl, 3*m+lwork_dorgbr_q)
+				wrkbl = max(wrkbl, bdspac)
+				maxwrk = max(m*m+wrkbl, m*m+m*n+m)
+			} else if wantvs && wantun {
+				// Path 4t (n much larger than m, jobU == None, jobVT == Store)
+				wrkbl = m + lwork_dgelqf
+				wrkbl = max(wrkbl, m+lwork_dorglq_m)
+				wrkbl = max(wrkbl, 3*m+lwork_dgebrd)
+				wrkbl = max(wrkbl, 3*m+lwork_dorgbr_p)
+				wrkbl = max(wrkbl, bdspac)
+				maxwrk = m*m + wrkbl
+			} else if wantvs && wantuo {
+				// Path 5t (n much larger than m, jobU == Overwrite, jobVT == Store)
+				wrkbl = m + lwork_dgelqf
+				wrkbl = max(wrkbl, m+lwork_dorglq_m)
+				wrkbl = max(wrkbl, 3*m+lwork_dgebrd)
+				wrkbl = max(wrkbl, 3*m+lwork_dorgbr_p)
+				wrkbl = max(wrkbl, 3*m+lwork_dorgbr_q)
+				wrkbl = max(wrkbl, bdspac)
+				maxwrk = 2*m*m + wrkbl
+			} else if wantvs && wantuas {
+				// Path 6t (n much larger than m, jobU == Store or All, jobVT == Store)
+				wrkbl = m + lwork_dgelqf
+				wrkbl = max(wrkbl, m+lwork_dorglq_m)
+				wrkbl = max(wrkbl, 3*m+lwork_dgebrd)
+				wrkbl = max(wrkbl, 3*m+lwork_dorgbr_p)
+				wrkbl = max(wrkbl, 3*m+lwork_dorgbr_q)
+				wrkbl = max(wrkbl, bdspac)
+				maxwrk = m*m + wrkbl
+			} else if wantva && wantun {
+				// Path 7t (n much larger than m, jobU== None, jobVT == All)
+				wrkbl = m + lwork_dgelqf
+				wrkbl = max(wrkbl, m+lwork_dorglq_n)
+				wrkbl = max(wrkbl, 3*m+lwork_dgebrd)
+				wrkbl = max(wrkbl, 3*m+lwork_dorgbr_p)
+				wrkbl = max(wrkbl, bdspac)
+				maxwrk = m*m + wrkbl
+			} else if wantva && wantuo {
+				// Path 8t (n much larger than m, jobU == Overwrite, jobVT == All)
+				wrkbl = m + lwork_dgelqf
+				wrkbl = max(wrkbl, m+lwork_dorglq_n)
+				wrkbl = max(wrkbl, 3*m+lwork_dgebrd)
+				wrkbl = max(wrkbl, 3*m+lwork_dorgbr_p)
+				wrkbl = max(wrkbl, 3*m+lwork_dorgbr_q)
+				wrkbl = max(wrkbl, bdspac)
+				maxwrk = 2*m*m + wrkbl
+			} else if wantva && wantuas {
+				// Path 9t (n much larger than m, jobU == Store or All, jobVT == All)
+				wrkbl = m + lwork_dgelqf
+				wrkbl = max(wrkbl, m+lwork_dorglq_n)
+				wrkbl = max(wrkbl, 3*m+lwork_dgebrd)
+				wrkbl = max(wrkbl, 3*m+lwork_dorgbr_p)
+				wrkbl = max(wrkbl, 3*m+lwork_dorgbr_q)
+				wrkbl = max(wrkbl, bdspac)
+				maxwrk = m*m + wrkbl
+			}
+		} else {
+			// Path 10t (n greater than m, but not much larger)
+			Gebrd(m, n, a, lda, s, nil, nil, nil, work, -1)
+			lwork_dgebrd = int(work[0])
+			maxwrk = 3*m + lwork_dgebrd
+			if wantvs || wantvo {
+				Orgbr(lapack.GeneratePT, m, n, m, a, n, nil, work, -1)
+				lwork_dorgbr_p = int(work[0])
+				maxwrk = max(maxwrk, 3*m+lwork_dorgbr_p)
+			}
+			if wantva {
+				Orgbr(lapack.GeneratePT, n, n, m, a, n, nil, work, -1)
+				lwork_dorgbr_p = int(work[0])
+				maxwrk = max(maxwrk, 3*m+lwork_dorgbr_p)
+			}
+			if !wantun {
+				maxwrk = max(maxwrk, 3*m+lwork_dorgbr_q)
+			}
+			maxwrk = max(maxwrk, bdspac)
+		}
+	}
+
+	maxwrk = max(maxwrk, minwork)
+	if lwork == -1 {
+		work[0] = float64(maxwrk)
+		return true
+	}
+
+	if len(a) < (m-1)*lda+n {
+		panic(lapack.ErrShortA)
+	}
+	if len(s) < minmn {
+		panic(lapack.ErrShortS)
+	}
+	if (len(u) < (m-1)*ldu+m && wantua) || (len(u) < (m-1)*ldu+minmn && wantus) {
+		panic(lapack.ErrShortU)
+	}
+	if (len(vt) < (n-1)*ldvt+n && wantva) || (len(vt) < (minmn-1)*ldvt+n && wantvs) {
+		panic(lapack.ErrShortVT)
+	}
+
+	// Perform decomposition.
+	eps := lamchE
+	smlnum := math.Sqrt(lamchS) / eps
+	bignum := 1 / smlnum
+
+	// Scale A if max element outside range [smlnum, bignum].
+	anrm := Lange(lapack.MaxAbs, m, n, a, lda, nil)
+	var iscl bool
+	if anrm > 0 && anrm < smlnum {
+		iscl = true
+		Lascl(lapack.General, 0, 0, anrm, smlnum, m, n, a, lda)
+	} else if anrm > bignum {
+		iscl = true
+		Lascl(lapack.General, 0, 0, anrm, bignum, m, n, a, lda)
+	}
+	var ie int
+	if m >= n {
+		// If A has sufficiently more rows than columns, use the QR decomposition.
+		if m >= mnthr {
+			// m >> n
+			if wantun {
+				// Path 1.
+				itau := 0
+				iwork := itau + n
+
+				// Compute A = Q * R.
+				Geqrf(m, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+
+				// Zero out below R.
+				if n > 1 {
+					Laset(blas.Lower, n-1, n-1, 0, 0, a[lda:], lda)
+				}
+				ie = 0
+				itauq := ie + n
+				itaup := itauq + n
+				iwork = itaup + n
+				// Bidiagonalize R in A.
+				Gebrd(n, n, a, lda, s, work[ie:], work[itauq:],
+					work[itaup:], work[iwork:], lwork-iwork)
+				ncvt := 0
+				if wantvo || wantvas {
+					Orgbr(lapack.GeneratePT, n, n, n, a, lda, work[itaup:],
+						work[iwork:], lwork-iwork)
+					ncvt = n
+				}
+				iwork = ie + n
+
+				// Perform bidiagonal QR iteration computing right singular vectors
+				// of A in A if desired.
+				ok = Bdsqr(blas.Upper, n, ncvt, 0, 0, s, work[ie:],
+					a, lda, work, 1, work, 1, work[iwork:])
+
+				// If right singular vectors desired in VT, copy them there.
+				if wantvas {
+					Lacpy(blas.All, n, n, a, lda, vt, ldvt)
+				}
+			} else if wantuo && wantvn {
+				// Path 2
+				if lwork >= n*n+max(4*n, bdspac) {
+					// Sufficient workspace for a fast algorithm.
+					ir := 0
+					var ldworkr int
+					if lwork >= max(wrkbl, m*n+n)+lda*n {
+						ldworkr = lda
+					} else {
+						ldworkr = n
+					}
+					itau := ir + ldworkr*n
+					iwork := itau + n
+
+					// Compute A = Q * R.
+					Geqrf(m, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+
+					// Copy R to work[ir:], zeroing out below it.
+					Lacpy(blas.Upper, n, n, a, lda, work[ir:], ldworkr)
+					Laset(blas.Lower, n-1, n-1, 0, 0, work[ir+ldworkr:], ldworkr)
+
+					// Generate Q in A.
+					Orgqr(m, n, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+					ie = itau
+					itauq := ie + n
+					itaup := itauq + n
+					iwork = itaup + n
+
+					// Bidiagonalize R in work[ir:].
+					Gebrd(n, n, work[ir:], ldworkr, s, work[ie:],
+						work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+					// Generate left vectors bidiagonalizing R.
+					Orgbr(lapack.GenerateQ, n, n, n, work[ir:], ldworkr,
+						work[itauq:], work[iwork:], lwork-iwork)
+					iwork = ie + n
+
+					// Perform bidiagonal QR iteration, computing left singular
+					// vectors of R in work[ir:].
+					ok = Bdsqr(blas.Upper, n, 0, n, 0, s, work[ie:], work, 1,
+						work[ir:], ldworkr, work, 1, work[iwork:])
+
+					// Multiply Q in A by left singular vectors of R in work[ir:],
+					// storing the result in work[iu:] chunk rows at a time and
+					// copying it to A.
+					iu := ie + n
+					chunk := min(m, (lwork-iu)/n)
+					for i := 0; i < m; i += chunk {
+						blk := min(m-i, chunk)
+						blas64.Gemm(blas.NoTrans, blas.NoTrans, blk, n, n, 1, a[i*lda:], lda,
+							work[ir:], ldworkr, 0, work[iu:], n)
+						Lacpy(blas.All, blk, n, work[iu:], n, a[i*lda:], lda)
+					}
+				} else {
+					// Insufficient workspace for a fast algorithm.
+					ie = 0
+					itauq := ie + n
+					itaup := itauq + n
+					iwork := itaup + n
+
+					// Bidiagonalize A.
+					Gebrd(m, n, a, lda, s, work[ie:],
+						work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+					// Generate left vectors bidiagonalizing A.
+					Orgbr(lapack.GenerateQ, m, n, n, a, lda,
+						work[itauq:], work[iwork:], lwork-iwork)
+					iwork = ie + n
+
+					// Perform bidiagonal QR iteration, computing left singular
+					// vectors of A in A.
+					ok = Bdsqr(blas.Upper, n, 0, m, 0, s, work[ie:], work, 1,
+						a, lda, work, 1, work[iwork:])
+				}
+			} else if wantuo && wantvas {
+				// Path 3
+				if lwork >= n*n+max(4*n, bdspac) {
+					// Sufficient workspace for a fast algorithm.
+					ir := 0
+					var ldworkr int
+					if lwork >= max(wrkbl, m*n+n)+lda*n {
+						ldworkr = lda
+					} else {
+						ldworkr = n
+					}
+					itau := ir + ldworkr*n
+					iwork := itau + n
+
+					// Compute A = Q * R.
+					Geqrf(m, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+
+					// Copy R to VT, zeroing out below it.
+					Lacpy(blas.Upper, n, n, a, lda, vt, ldvt)
+					if n > 1 {
+						if n > 1 {
+							Laset(blas.Lower, n-1, n-1, 0, 0, vt[ldvt:], ldvt)
+						}
+					}
+
+					// Generate Q in A.
+					Orgqr(m, n, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+					ie = itau
+					itauq := ie + n
+					itaup := itauq + n
+					iwork = itaup + n
+
+					// Bidiagonalize R in VT, copying result to work[ir:].
+					Gebrd(n, n, vt, ldvt, s, work[ie:],
+						work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+					Lacpy(blas.Lower, n, n, vt, ldvt, work[ir:], ldworkr)
+
+					// Generate left vectors bidiagonalizing R in work[ir:].
+					Orgbr(lapack.GenerateQ, n, n, n, work[ir:], ldworkr,
+						work[itauq:], work[iwork:], lwork-iwork)
+
+					// Generate right vectors bidiagonalizing R in VT.
+					Orgbr(lapack.GeneratePT, n, n, n, vt, ldvt,
+						work[itaup:], work[iwork:], lwork-iwork)
+					iwork = ie + n
+
+					// Perform bidiagonal QR iteration, computing left singular
+					// vectors of R in work[ir:] and computing right singular
+					// vectors of R in VT.
+					ok = Bdsqr(blas.Upper, n, n, n, 0, s, work[ie:], vt, ldvt,
+						work[ir:], ldworkr, work, 1, work[iwork:])
+
+					// Multiply Q in A by left singular vectors of R in work[ir:],
+					// storing the result in work[iu:] chunk rows at a time and
+					// copying it to A.
+					iu := ie + n
+					chunk := min(m, (lwork-iu)/n)
+					for i := 0; i < m; i += chunk {
+						blk := min(m-i, chunk)
+						blas64.Gemm(blas.NoTrans, blas.NoTrans, blk, n, n, 1, a[i*lda:], lda,
+							work[ir:], ldworkr, 0, work[iu:], n)
+						Lacpy(blas.All, blk, n, work[iu:], n, a[i*lda:], lda)
+					}
+				} else {
+					// Insufficient workspace for a fast algorithm.
+					itau := 0
+					iwork := itau + n
+
+					// Compute A = Q * R.
+					Geqrf(m, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+
+					// Copy R to VT, zeroing out below it.
+					Lacpy(blas.Upper, n, n, a, lda, vt, ldvt)
+					if n > 1 {
+						if n > 1 {
+							Laset(blas.Lower, n-1, n-1, 0, 0, vt[ldvt:], ldvt)
+						}
+					}
+
+					// Generate Q in A.
+					Orgqr(m, n, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+					ie = itau
+					itauq := ie + n
+					itaup := itauq + n
+					iwork = itaup + n
+
+					// Bidiagonalize R in VT.
+					Gebrd(n, n, vt, ldvt, s, work[ie:],
+						work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+					// Multiply Q in A by left vectors bidiagonalizing R.
+					Ormbr(lapack.ApplyQ, blas.Right, blas.NoTrans, m, n, n,
+						vt, ldvt, work[itauq:], a, lda, work[iwork:], lwork-iwork)
+
+					// Generate right vectors bidiagonalizing R in VT.
+					Orgbr(lapack.GeneratePT, n, n, n, vt, ldvt,
+						work[itaup:], work[iwork:], lwork-iwork)
+					iwork = ie + n
+
+					// Perform bidiagonal QR iteration, computing left singular
+					// vectors of A in A and computing right singular vectors of
+					// A in VT.
+					ok = Bdsqr(blas.Upper, n, n, m, 0, s, work[ie:], vt, ldvt,
+						a, lda, work, 1, work[iwork:])
+				}
+			} else if wantus {
+				if wantvn {
+					// Path 4
+					if lwork >= n*n+max(4*n, bdspac) {
+						// Sufficient workspace for a fast algorithm.
+						ir := 0
+						var ldworkr int
+						if lwork >= wrkbl+lda*n {
+							ldworkr = lda
+						} else {
+							ldworkr = n
+						}
+						itau := ir + ldworkr*n
+						iwork := itau + n
+						// Compute A = Q * R.
+						Geqrf(m, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+
+						// Copy R to work[ir:], zeroing out below it.
+						Lacpy(blas.Upper, n, n, a, lda, work[ir:], ldworkr)
+						Laset(blas.Lower, n-1, n-1, 0, 0, work[ir+ldworkr:], ldworkr)
+
+						// Generate Q in A.
+						Orgqr(m, n, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+						ie := itau
+						itauq := ie + n
+						itaup := itauq + n
+						iwork = itaup + n
+
+						// Bidiagonalize R in work[ir:].
+						Gebrd(n, n, work[ir:], ldworkr, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+						// Generate left vectors bidiagonalizing R in work[ir:].
+						Orgbr(lapack.GenerateQ, n, n, n, work[ir:], ldworkr,
+							work[itauq:], work[iwork:], lwork-iwork)
+						iwork = ie + n
+
+						// Perform bidiagonal QR iteration, computing left singular
+						// vectors of R in work[ir:].
+						ok = Bdsqr(blas.Upper, n, 0, n, 0, s, work[ie:], work, 1,
+							work[ir:], ldworkr, work, 1, work[iwork:])
+
+						// Multiply Q in A by left singular vectors of R in
+						// work[ir:], storing result in U.
+						blas64.Gemm(blas.NoTrans, blas.NoTrans, m, n, n, 1, a, lda,
+							work[ir:], ldworkr, 0, u, ldu)
+					} else {
+						// Insufficient workspace for a fast algorithm.
+						itau := 0
+						iwork := itau + n
+
+						// Compute A = Q*R, copying result to U.
+						Geqrf(m, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+						Lacpy(blas.Lower, m, n, a, lda, u, ldu)
+
+						// Generate Q in U.
+						Orgqr(m, n, n, u, ldu, work[itau:itau+n], work[iwork:], lwork-iwork)
+						ie := itau
+						itauq := ie + n
+						itaup := itauq + n
+						iwork = itaup + n
+
+						// Zero out below R in A.
+						if n > 1 {
+							Laset(blas.Lower, n-1, n-1, 0, 0, a[lda:], lda)
+						}
+
+						// Bidiagonalize R in A.
+						Gebrd(n, n, a, lda, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+						// Multiply Q in U by left vectors bidiagonalizing R.
+						Ormbr(lapack.ApplyQ, blas.Right, blas.NoTrans, m, n, n,
+							a, lda, work[itauq:], u, ldu, work[iwork:], lwork-iwork)
+						iwork = ie + n
+
+						// Perform bidiagonal QR iteration, computing left
+						// singular vectors of A in U.
+						ok = Bdsqr(blas.Upper, n, 0, m, 0, s, work[ie:], work, 1,
+							u, ldu, work, 1, work[iwork:])
+					}
+				} else if wantvo {
+					// Path 5
+					if lwork >= 2*n*n+max(4*n, bdspac) {
+						// Sufficient workspace for a fast algorithm.
+						iu := 0
+						var ldworku, ldworkr int
+						if lwork >= wrkbl+2*lda*n {
+							ldworku = lda
+							ldworkr = lda
+						} else if lwork >= wrkbl+(lda+n)*n {
+							ldworku = lda
+							ldworkr = n
+						} else {
+							ldworku = n
+							ldworkr = n
+						}
+						ir := iu + ldworku*n
+						itau := ir + ldworkr*n
+						iwork := itau + n
+
+						// Compute A = Q * R.
+						Geqrf(m, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+
+						// Copy R to work[iu:], zeroing out below it.
+						Lacpy(blas.Upper, n, n, a, lda, work[iu:], ldworku)
+						Laset(blas.Lower, n-1, n-1, 0, 0, work[iu+ldworku:], ldworku)
+
+						// Generate Q in A.
+						Orgqr(m, n, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+						ie = itau
+						itauq := ie + n
+						itaup := itauq + n
+						iwork = itaup + n
+
+						// Bidiagonalize R in work[iu:], copying result to work[ir:].
+						Gebrd(n, n, work[iu:], ldworku, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+						Lacpy(blas.Upper, n, n, work[iu:], ldworku, work[ir:], ldworkr)
+
+						// Generate left bidiagonalizing vectors in work[iu:].
+						Orgbr(lapack.GenerateQ, n, n, n, work[iu:], ldworku,
+							work[itauq:], work[iwork:], lwork-iwork)
+
+						// Generate right bidiagonalizing vectors in work[ir:].
+						Orgbr(lapack.GeneratePT, n, n, n, work[ir:], ldworkr,
+							work[itaup:], work[iwork:], lwork-iwork)
+						iwork = ie + n
+
+						// Perform bidiagonal QR iteration, computing left singular
+						// vectors of R in work[iu:] and computing right singular
+						// vectors of R in work[ir:].
+						ok = Bdsqr(blas.Upper, n, n, n, 0, s, work[ie:], work[ir:], ldworkr,
+							work[iu:], ldworku, work, 1, work[iwork:])
+
+						// Multiply Q in A by left singular vectors of R in
+						// work[iu:], storing result in U.
+						blas64.Gemm(blas.NoTrans, blas.NoTrans, m, n, n, 1, a, lda,
+							work[iu:], ldworku, 0, u, ldu)
+
+						// Copy right singular vectors of R to A.
+						Lacpy(blas.All, n, n, work[ir:], ldworkr, a, lda)
+					} else {
+						// Insufficient workspace for a fast algorithm.
+						itau := 0
+						iwork := itau + n
+
+						// Compute A = Q * R, copying result to U.
+						Geqrf(m, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+						Lacpy(blas.Lower, m, n, a, lda, u, ldu)
+
+						// Generate Q in U.
+						Orgqr(m, n, n, u, ldu, work[itau:itau+n], work[iwork:], lwork-iwork)
+						ie = itau
+						itauq := ie + n
+						itaup := itauq + n
+						iwork = itaup + n
+
+						// Zero out below R in A.
+						if n > 1 {
+							Laset(blas.Lower, n-1, n-1, 0, 0, a[lda:], lda)
+						}
+
+						// Bidiagonalize R in A.
+						Gebrd(n, n, a, lda, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+						// Multiply Q in U by left vectors bidiagonalizing R.
+						Ormbr(lapack.ApplyQ, blas.Right, blas.NoTrans, m, n, n,
+							a, lda, work[itauq:], u, ldu, work[iwork:], lwork-iwork)
+
+						// Generate right vectors bidiagonalizing R in A.
+						Orgbr(lapack.GeneratePT, n, n, n, a, lda,
+							work[itaup:], work[iwork:], lwork-iwork)
+						iwork = ie + n
+
+						// Perform bidiagonal QR iteration, computing left singular
+						// vectors of A in U and computing right singular vectors of
+						// A in A.
+						ok = Bdsqr(blas.Upper, n, n, m, 0, s, work[ie:], a, lda,
+							u, ldu, work, 1, work[iwork:])
+					}
+				} else if wantvas {
+					// Path 6
+					if lwork >= n*n+max(4*n, bdspac) {
+						// Sufficient workspace for a fast algorithm.
+						iu := 0
+						var ldworku int
+						if lwork >= wrkbl+lda*n {
+							ldworku = lda
+						} else {
+							ldworku = n
+						}
+						itau := iu + ldworku*n
+						iwork := itau + n
+
+						// Compute A = Q * R.
+						Geqrf(m, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+						// Copy R to work[iu:], zeroing out below it.
+						Lacpy(blas.Upper, n, n, a, lda, work[iu:], ldworku)
+						Laset(blas.Lower, n-1, n-1, 0, 0, work[iu+ldworku:], ldworku)
+
+						// Generate Q in A.
+						Orgqr(m, n, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+
+						ie := itau
+						itauq := ie + n
+						itaup := itauq + n
+						iwork = itaup + n
+
+						// Bidiagonalize R in work[iu:], copying result to VT.
+						Gebrd(n, n, work[iu:], ldworku, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+						Lacpy(blas.Upper, n, n, work[iu:], ldworku, vt, ldvt)
+
+						// Generate left bidiagonalizing vectors in work[iu:].
+						Orgbr(lapack.GenerateQ, n, n, n, work[iu:], ldworku,
+							work[itauq:], work[iwork:], lwork-iwork)
+
+						// Generate right bidiagonalizing vectors in VT.
+						Orgbr(lapack.GeneratePT, n, n, n, vt, ldvt,
+							work[itaup:], work[iwork:], lwork-iwork)
+						iwork = ie + n
+
+						// Perform bidiagonal QR iteration, computing left singular
+						// vectors of R in work[iu:], and computing right singular
+						// vectors of R in VT.
+						ok = Bdsqr(blas.Upper, n, n, n, 0, s, work[ie:],
+							vt, ldvt, work[iu:], ldworku, work, 1, work[iwork:])
+
+						// Multiply Q in A by left singular vectors of R in
+						// work[iu:], storing result in U.
+						blas64.Gemm(blas.NoTrans, blas.NoTrans, m, n, n, 1, a, lda,
+							work[iu:], ldworku, 0, u, ldu)
+					} else {
+						// Insufficient workspace for a fast algorithm.
+						itau := 0
+						iwork := itau + n
+
+						// Compute A = Q * R, copying result to U.
+						Geqrf(m, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+						Lacpy(blas.Lower, m, n, a, lda, u, ldu)
+
+						// Generate Q in U.
+						Orgqr(m, n, n, u, ldu, work[itau:itau+n], work[iwork:], lwork-iwork)
+
+						// Copy R to VT, zeroing out below it.
+						Lacpy(blas.Upper, n, n, a, lda, vt, ldvt)
+						if n > 1 {
+							Laset(blas.Lower, n-1, n-1, 0, 0, vt[ldvt:], ldvt)
+						}
+
+						ie := itau
+						itauq := ie + n
+						itaup := itauq + n
+						iwork = itaup + n
+
+						// Bidiagonalize R in VT.
+						Gebrd(n, n, vt, ldvt, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+						// Multiply Q in U by left bidiagonalizing vectors in VT.
+						Ormbr(lapack.ApplyQ, blas.Right, blas.NoTrans, m, n, n,
+							vt, ldvt, work[itauq:], u, ldu, work[iwork:], lwork-iwork)
+
+						// Generate right bidiagonalizing vectors in VT.
+						Orgbr(lapack.GeneratePT, n, n, n, vt, ldvt,
+							work[itaup:], work[iwork:], lwork-iwork)
+						iwork = ie + n
+
+						// Perform bidiagonal QR iteration, computing left singular
+						// vectors of A in U and computing right singular vectors
+						// of A in VT.
+						ok = Bdsqr(blas.Upper, n, n, m, 0, s, work[ie:],
+							vt, ldvt, u, ldu, work, 1, work[iwork:])
+					}
+				}
+			} else if wantua {
+				if wantvn {
+					// Path 7
+					if lwork >= n*n+max(max(n+m, 4*n), bdspac) {
+						// Sufficient workspace for a fast algorithm.
+						ir := 0
+						var ldworkr int
+						if lwork >= wrkbl+lda*n {
+							ldworkr = lda
+						} else {
+							ldworkr = n
+						}
+						itau := ir + ldworkr*n
+						iwork := itau + n
+
+						// Compute A = Q*R, copying result to U.
+						Geqrf(m, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+						Lacpy(blas.Lower, m, n, a, lda, u, ldu)
+
+						// Copy R to work[ir:], zeroing out below it.
+						Lacpy(blas.Upper, n, n, a, lda, work[ir:], ldworkr)
+						Laset(blas.Lower, n-1, n-1, 0, 0, work[ir+ldworkr:], ldworkr)
+
+						// Generate Q in U.
+						Orgqr(m, m, n, u, ldu, work[itau:itau+n], work[iwork:], lwork-iwork)
+						ie := itau
+						itauq := ie + n
+						itaup := itauq + n
+						iwork = itaup + n
+
+						// Bidiagonalize R in work[ir:].
+						Gebrd(n, n, work[ir:], ldworkr, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+						// Generate left bidiagonalizing vectors in work[ir:].
+						Orgbr(lapack.GenerateQ, n, n, n, work[ir:], ldworkr,
+							work[itauq:], work[iwork:], lwork-iwork)
+						iwork = ie + n
+
+						// Perform bidiagonal QR iteration, computing left singular
+						// vectors of R in work[ir:].
+						ok = Bdsqr(blas.Upper, n, 0, n, 0, s, work[ie:], work, 1,
+							work[ir:], ldworkr, work, 1, work[iwork:])
+
+						// Multiply Q in U by left singular vectors of R in
+						// work[ir:], storing result in A.
+						blas64.Gemm(blas.NoTrans, blas.NoTrans, m, n, n, 1, u, ldu,
+							work[ir:], ldworkr, 0, a, lda)
+
+						// Copy left singular vectors of A from A to U.
+						Lacpy(blas.All, m, n, a, lda, u, ldu)
+					} else {
+						// Insufficient workspace for a fast algorithm.
+						itau := 0
+						iwork := itau + n
+
+						// Compute A = Q*R, copying result to U.
+						Geqrf(m, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+						Lacpy(blas.Lower, m, n, a, lda, u, ldu)
+
+						// Generate Q in U.
+						Orgqr(m, m, n, u, ldu, work[itau:itau+n], work[iwork:], lwork-iwork)
+						ie := itau
+						itauq := ie + n
+						itaup := itauq + n
+						iwork = itaup + n
+
+						// Zero out below R in A.
+						if n > 1 {
+							Laset(blas.Lower, n-1, n-1, 0, 0, a[lda:], lda)
+						}
+
+						// Bidiagonalize R in A.
+						Gebrd(n, n, a, lda, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+						// Multiply Q in U by left bidiagonalizing vectors in A.
+						Ormbr(lapack.ApplyQ, blas.Right, blas.NoTrans, m, n, n,
+							a, lda, work[itauq:], u, ldu, work[iwork:], lwork-iwork)
+						iwork = ie + n
+
+						// Perform bidiagonal QR iteration, computing left
+						// singular vectors of A in U.
+						ok = Bdsqr(blas.Upper, n, 0, m, 0, s, work[ie:],
+							work, 1, u, ldu, work, 1, work[iwork:])
+					}
+				} else if wantvo {
+					// Path 8.
+					if lwork >= 2*n*n+max(n+m, 4*n, bdspac) {
+						// Sufficient workspace for a fast algorithm.
+						iu := 0
+						var ldworku, ldworkr int
+						if lwork >= wrkbl+2*lda*n {
+							ldworku = lda
+							ldworkr = lda
+						} else if lwork >= wrkbl+(lda+n)*n {
+							ldworku = lda
+							ldworkr = n
+						} else {
+							ldworku = n
+							ldworkr = n
+						}
+						ir := iu + ldworku*n
+						itau := ir + ldworkr*n
+						iwork := itau + n
+
+						// Compute A = Q * R, copying result to U.
+						Geqrf(m, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+						Lacpy(blas.Lower, m, n, a, lda, u, ldu)
+
+						// Generate Q in U.
+						Orgqr(m, m, n, u, ldu, work[itau:itau+n], work[iwork:], lwork-iwork)
+
+						// Copy R to work[iu:], zeroing out below it.
+						Lacpy(blas.Upper, n, n, a, lda, work[iu:], ldworku)
+						Laset(blas.Lower, n-1, n-1, 0, 0, work[iu+ldworku:], ldworku)
+						ie = itau
+						itauq := ie + n
+						itaup := itauq + n
+						iwork = itaup + n
+
+						// Bidiagonalize R in work[iu:], copying result to work[ir:].
+						Gebrd(n, n, work[iu:], ldworku, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+						Lacpy(blas.Upper, n, n, work[iu:], ldworku, work[ir:], ldworkr)
+
+						// Generate left bidiagonalizing vectors in work[iu:].
+						Orgbr(lapack.GenerateQ, n, n, n, work[iu:], ldworku,
+							work[itauq:], work[iwork:], lwork-iwork)
+
+						// Generate right bidiagonalizing vectors in work[ir:].
+						Orgbr(lapack.GeneratePT, n, n, n, work[ir:], ldworkr,
+							work[itaup:], work[iwork:], lwork-iwork)
+						iwork = ie + n
+
+						// Perform bidiagonal QR iteration, computing left singular
+						// vectors of R in work[iu:] and computing right singular
+						// vectors of R in work[ir:].
+						ok = Bdsqr(blas.Upper, n, n, n, 0, s, work[ie:], work[ir:], ldworkr,
+							work[iu:], ldworku, work, 1, work[iwork:])
+
+						// Multiply Q in U by left singular vectors of R in
+						// work[iu:], storing result in A.
+						blas64.Gemm(blas.NoTrans, blas.NoTrans, m, n, n, 1, u, ldu,
+							work[iu:], ldworku, 0, a, lda)
+
+						// Copy left singular vectors of A from A to U.
+						Lacpy(blas.All, m, n, a, lda, u, ldu)
+
+						// Copy right singular vectors of R from work[ir:] to A.
+						Lacpy(blas.All, n, n, work[ir:], ldworkr, a, lda)
+					} else {
+						// Insufficient workspace for a fast algorithm.
+						itau := 0
+						iwork := itau + n
+
+						// Compute A = Q * R, copying result to U.
+						Geqrf(m, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+						Lacpy(blas.Lower, m, n, a, lda, u, ldu)
+
+						// Generate Q in U.
+						Orgqr(m, m, n, u, ldu, work[itau:itau+n], work[iwork:], lwork-iwork)
+						ie = itau
+						itauq := ie + n
+						itaup := itauq + n
+						iwork = itaup + n
+
+						// Zero out below R in A.
+						if n > 1 {
+							Laset(blas.Lower, n-1, n-1, 0, 0, a[lda:], lda)
+						}
+
+						// Bidiagonalize R in A.
+						Gebrd(n, n, a, lda, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+						// Multiply Q in U by left bidiagonalizing vectors in A.
+						Ormbr(lapack.ApplyQ, blas.Right, blas.NoTrans, m, n, n,
+							a, lda, work[itauq:], u, ldu, work[iwork:], lwork-iwork)
+
+						// Generate right bidiagonalizing vectors in A.
+						Orgbr(lapack.GeneratePT, n, n, n, a, lda,
+							work[itaup:], work[iwork:], lwork-iwork)
+						iwork = ie + n
+
+						// Perform bidiagonal QR iteration, computing left singular
+						// vectors of A in U and computing right singular vectors of
+						// A in A.
+						ok = Bdsqr(blas.Upper, n, n, m, 0, s, work[ie:], a, lda,
+							u, ldu, work, 1, work[iwork:])
+					}
+				} else if wantvas {
+					// Path 9.
+					if lwork >= n*n+max(max(n+m, 4*n), bdspac) {
+						// Sufficient workspace for a fast algorithm.
+						iu := 0
+						var ldworku int
+						if lwork >= wrkbl+lda*n {
+							ldworku = lda
+						} else {
+							ldworku = n
+						}
+						itau := iu + ldworku*n
+						iwork := itau + n
+
+						// Compute A = Q * R, copying result to U.
+						Geqrf(m, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+						Lacpy(blas.Lower, m, n, a, lda, u, ldu)
+
+						// Generate Q in U.
+						Orgqr(m, m, n, u, ldu, work[itau:itau+n], work[iwork:], lwork-iwork)
+
+						// Copy R to work[iu:], zeroing out below it.
+						Lacpy(blas.Upper, n, n, a, lda, work[iu:], ldworku)
+						Laset(blas.Lower, n-1, n-1, 0, 0, work[iu+ldworku:], ldworku)
+
+						ie = itau
+						itauq := ie + n
+						itaup := itauq + n
+						iwork = itaup + n
+
+						// Bidiagonalize R in work[iu:], copying result to VT.
+						Gebrd(n, n, work[iu:], ldworku, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+						Lacpy(blas.Upper, n, n, work[iu:], ldworku, vt, ldvt)
+
+						// Generate left bidiagonalizing vectors in work[iu:].
+						Orgbr(lapack.GenerateQ, n, n, n, work[iu:], ldworku,
+							work[itauq:], work[iwork:], lwork-iwork)
+
+						// Generate right bidiagonalizing vectors in VT.
+						Orgbr(lapack.GeneratePT, n, n, n, vt, ldvt,
+							work[itaup:], work[iwork:], lwork-iwork)
+						iwork = ie + n
+
+						// Perform bidiagonal QR iteration, computing left singular
+						// vectors of R in work[iu:] and computing right
+						// singular vectors of R in VT.
+						ok = Bdsqr(blas.Upper, n, n, n, 0, s, work[ie:],
+							vt, ldvt, work[iu:], ldworku, work, 1, work[iwork:])
+
+						// Multiply Q in U by left singular vectors of R in
+						// work[iu:], storing result in A.
+						blas64.Gemm(blas.NoTrans, blas.NoTrans, m, n, n, 1,
+							u, ldu, work[iu:], ldworku, 0, a, lda)
+
+						// Copy left singular vectors of A from A to U.
+						Lacpy(blas.All, m, n, a, lda, u, ldu)
+
+						/*
+							// Bidiagonalize R in VT.
+							Gebrd(n, n, vt, ldvt, s, work[ie:],
+								work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+							// Multiply Q in U by left bidiagonalizing vectors in VT.
+							Ormbr(lapack.ApplyQ, blas.Right, blas.NoTrans,
+								m, n, n, vt, ldvt, work[itauq:], u, ldu, work[iwork:], lwork-iwork)
+
+							// Generate right bidiagonalizing vectors in VT.
+							Orgbr(lapack.GeneratePT, n, n, n, vt, ldvt,
+								work[itaup:], work[iwork:], lwork-iwork)
+							iwork = ie + n
+
+							// Perform bidiagonal QR iteration, computing left singular
+							// vectors of A in U and computing right singular vectors
+							// of A in VT.
+							ok = Bdsqr(blas.Upper, n, n, m, 0, s, work[ie:],
+								vt, ldvt, u, ldu, work, 1, work[iwork:])
+						*/
+					} else {
+						// Insufficient workspace for a fast algorithm.
+						itau := 0
+						iwork := itau + n
+
+						// Compute A = Q*R, copying result to U.
+						Geqrf(m, n, a, lda, work[itau:itau+n], work[iwork:], lwork-iwork)
+						Lacpy(blas.Lower, m, n, a, lda, u, ldu)
+
+						// Generate Q in U.
+						Orgqr(m, m, n, u, ldu, work[itau:itau+n], work[iwork:], lwork-iwork)
+
+						// Copy R from A to VT, zeroing out below it.
+						Lacpy(blas.Upper, n, n, a, lda, vt, ldvt)
+						if n > 1 {
+							if n > 1 {
+								Laset(blas.Lower, n-1, n-1, 0, 0, vt[ldvt:], ldvt)
+							}
+						}
+
+						ie := itau
+						itauq := ie + n
+						itaup := itauq + n
+						iwork = itaup + n
+
+						// Bidiagonalize R in VT.
+						Gebrd(n, n, vt, ldvt, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+						// Multiply Q in U by left bidiagonalizing vectors in VT.
+						Ormbr(lapack.ApplyQ, blas.Right, blas.NoTrans,
+							m, n, n, vt, ldvt, work[itauq:], u, ldu, work[iwork:], lwork-iwork)
+
+						// Generate right bidiagonizing vectors in VT.
+						Orgbr(lapack.GeneratePT, n, n, n, vt, ldvt,
+							work[itaup:], work[iwork:], lwork-iwork)
+						iwork = ie + n
+
+						// Perform bidiagonal QR iteration, computing left singular
+						// vectors of A in U and computing right singular vectors
+						// of A in VT.
+						ok = Bdsqr(blas.Upper, n, n, m, 0, s, work[ie:],
+							vt, ldvt, u, ldu, work, 1, work[iwork:])
+					}
+				}
+			}
+		} else {
+			// Path 10.
+			// M at least N, but not much larger.
+			ie = 0
+			itauq := ie + n
+			itaup := itauq + n
+			iwork := itaup + n
+
+			// Bidiagonalize A.
+			Gebrd(m, n, a, lda, s, work[ie:], work[itauq:],
+				work[itaup:], work[iwork:], lwork-iwork)
+			if wantuas {
+				// Left singular vectors are desired in U. Copy result to U and
+				// generate left biadiagonalizing vectors in U.
+				Lacpy(blas.Lower, m, n, a, lda, u, ldu)
+				var ncu int
+				if wantus {
+					ncu = n
+				}
+				if wantua {
+					ncu = m
+				}
+				Orgbr(lapack.GenerateQ, m, ncu, n, u, ldu, work[itauq:], work[iwork:], lwork-iwork)
+			}
+			if wantvas {
+				// Right singular vectors are desired in VT. Copy result to VT and
+				// generate left biadiagonalizing vectors in VT.
+				Lacpy(blas.Upper, n, n, a, lda, vt, ldvt)
+				Orgbr(lapack.GeneratePT, n, n, n, vt, ldvt, work[itaup:], work[iwork:], lwork-iwork)
+			}
+			if wantuo {
+				// Left singular vectors are desired in A. Generate left
+				// bidiagonalizing vectors in A.
+				Orgbr(lapack.GenerateQ, m, n, n, a, lda, work[itauq:], work[iwork:], lwork-iwork)
+			}
+			if wantvo {
+				// Right singular vectors are desired in A. Generate right
+				// bidiagonalizing vectors in A.
+				Orgbr(lapack.GeneratePT, n, n, n, a, lda, work[itaup:], work[iwork:], lwork-iwork)
+			}
+			iwork = ie + n
+			var nru, ncvt int
+			if wantuas || wantuo {
+				nru = m
+			}
+			if wantun {
+				nru = 0
+			}
+			if wantvas || wantvo {
+				ncvt = n
+			}
+			if wantvn {
+				ncvt = 0
+			}
+			if !wantuo && !wantvo {
+				// Perform bidiagonal QR iteration, if desired, computing left
+				// singular vectors in U and right singular vectors in VT.
+				ok = Bdsqr(blas.Upper, n, ncvt, nru, 0, s, work[ie:],
+					vt, ldvt, u, ldu, work, 1, work[iwork:])
+			} else if !wantuo && wantvo {
+				// Perform bidiagonal QR iteration, if desired, computing left
+				// singular vectors in U and right singular vectors in A.
+				ok = Bdsqr(blas.Upper, n, ncvt, nru, 0, s, work[ie:],
+					a, lda, u, ldu, work, 1, work[iwork:])
+			} else {
+				// Perform bidiagonal QR iteration, if desired, computing left
+				// singular vectors in A and right singular vectors in VT.
+				ok = Bdsqr(blas.Upper, n, ncvt, nru, 0, s, work[ie:],
+					vt, ldvt, a, lda, work, 1, work[iwork:])
+			}
+		}
+	} else {
+		// A has more columns than rows. If A has sufficiently more columns than
+		// rows, first reduce using the LQ decomposition.
+		if n >= mnthr {
+			// n >> m.
+			if wantvn {
+				// Path 1t.
+				itau := 0
+				iwork := itau + m
+
+				// Compute A = L*Q.
+				Gelqf(m, n, a, lda, work[itau:], work[iwork:], lwork-iwork)
+
+				// Zero out above L.
+				Laset(blas.Upper, m-1, m-1, 0, 0, a[1:], lda)
+				ie := 0
+				itauq := ie + m
+				itaup := itauq + m
+				iwork = itaup + m
+
+				// Bidiagonalize L in A.
+				Gebrd(m, m, a, lda, s, work[ie:itauq],
+					work[itauq:itaup], work[itaup:iwork], work[iwork:], lwork-iwork)
+				if wantuo || wantuas {
+					Orgbr(lapack.GenerateQ, m, m, m, a, lda,
+						work[itauq:], work[iwork:], lwork-iwork)
+				}
+				iwork = ie + m
+				nru := 0
+				if wantuo || wantuas {
+					nru = m
+				}
+
+				// Perform bidiagonal QR iteration, computing left singular vectors
+				// of A in A if desired.
+				ok = Bdsqr(blas.Upper, m, 0, nru, 0, s, work[ie:],
+					work, 1, a, lda, work, 1, work[iwork:])
+
+				// If left singular vectors desired in U, copy them there.
+				if wantuas {
+					Lacpy(blas.All, m, m, a, lda, u, ldu)
+				}
+			} else if wantvo && wantun {
+				// Path 2t.
+				if lwork >= m*m+max(4*m, bdspac) {
+					// Sufficient workspace for a fast algorithm.
+					ir := 0
+					var ldworkr int
+					if lwork >= max(wrkbl, m*n+m)+lda*m {
+						ldworkr = lda
+					} else {
+						ldworkr = m
+					}
+					itau := ir + ldworkr*m
+					iwork := itau + m
+
+					// Compute A = L*Q.
+					Gelqf(m, n, a, lda, work[itau:], work[iwork:], lwork-iwork)
+
+					// Copy L to work[ir:], zeroing out above it.
+					Lacpy(blas.Lower, m, m, a, lda, work[ir:], ldworkr)
+					Laset(blas.Upper, m-1, m-1, 0, 0, work[ir+1:], ldworkr)
+
+					// Generate Q in A.
+					Orglq(m, n, m, a, lda, work[itau:], work[iwork:], lwork-iwork)
+					ie = itau
+					itauq := ie + m
+					itaup := itauq + m
+					iwork = itaup + m
+
+					// Bidiagonalize L in work[ir:].
+					Gebrd(m, m, work[ir:], ldworkr, s, work[ie:],
+						work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+					// Generate right vectors bidiagonalizing L.
+					Orgbr(lapack.GeneratePT, m, m, m, work[ir:], ldworkr,
+						work[itaup:], work[iwork:], lwork-iwork)
+					iwork = ie + m
+
+					// Perform bidiagonal QR iteration, computing right singular
+					// vectors of L in work[ir:].
+					ok = Bdsqr(blas.Upper, m, m, 0, 0, s, work[ie:], work[ir:], ldworkr,
+						work, 1, work, 1, work[iwork:])
+
+					// Multiply right singular vectors of L in work[ir:] by Q
+					// in A, storing the result in work[iu:] chunk columns at a
+					// time and copying it to A.
+					iu := ie + m
+					chunk := min(n, (lwork-iu)/m)
+					for i := 0; i < n; i += chunk {
+						blk := min(n-i, chunk)
+						blas64.Gemm(blas.NoTrans, blas.NoTrans, m, blk, m, 1, work[ir:], ldworkr,
+							a[i:], lda, 0, work[iu:], chunk)
+						Lacpy(blas.All, m, blk, work[iu:], chunk, a[i:], lda)
+					}
+				} else {
+					// Insufficient workspace for a fast algorithm.
+					ie = 0
+					itauq := ie + m
+					itaup := itauq + m
+					iwork := itaup + m
+
+					// Bidiagonalize A.
+					Gebrd(m, n, a, lda, s, work[ie:],
+						work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+					// Generate right vectors bidiagonalizing A.
+					Orgbr(lapack.GeneratePT, m, n, m, a, lda,
+						work[itaup:], work[iwork:], lwork-iwork)
+					iwork = ie + m
+
+					// Perform bidiagonal QR iteration, computing right singular
+					// vectors of A in A.
+					ok = Bdsqr(blas.Lower, m, n, 0, 0, s, work[ie:], a, lda,
+						work, 1, work, 1, work[iwork:])
+				}
+			} else if wantvo && wantuas {
+				// Path 3t.
+				if lwork >= m*m+max(4*m, bdspac) {
+					// Sufficient workspace for a fast algorithm.
+					ir := 0
+					var ldworkr int
+					if lwork >= max(wrkbl, m*n+m)+lda*m {
+						ldworkr = lda
+					} else {
+						ldworkr = m
+					}
+					itau := ir + ldworkr*m
+					iwork := itau + m
+
+					// Compute A = L*Q.
+					Gelqf(m, n, a, lda, work[itau:], work[iwork:], lwork-iwork)
+
+					// Copy L to U, zeroing out above it.
+					Lacpy(blas.Lower, m, m, a, lda, u, ldu)
+					Laset(blas.Upper, m-1, m-1, 0, 0, u[1:], ldu)
+
+					// Generate Q in A.
+					Orglq(m, n, m, a, lda, work[itau:], work[iwork:], lwork-iwork)
+					ie = itau
+					itauq := ie + m
+					itaup := itauq + m
+					iwork = itaup + m
+
+					// Bidiagonalize L in U, copying result to work[ir:].
+					Gebrd(m, m, u, ldu, s, work[ie:],
+						work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+					Lacpy(blas.Upper, m, m, u, ldu, work[ir:], ldworkr)
+
+					// Generate right vectors bidiagonalizing L in work[ir:].
+					Orgbr(lapack.GeneratePT, m, m, m, work[ir:], ldworkr,
+						work[itaup:], work[iwork:], lwork-iwork)
+
+					// Generate left vectors bidiagonalizing L in U.
+					Orgbr(lapack.GenerateQ, m, m, m, u, ldu,
+						work[itauq:], work[iwork:], lwork-iwork)
+					iwork = ie + m
+
+					// Perform bidiagonal QR iteration, computing left singular
+					// vectors of L in U and computing right singular vectors of
+					// L in work[ir:].
+					ok = Bdsqr(blas.Upper, m, m, m, 0, s, work[ie:], work[ir:], ldworkr,
+						u, ldu, work, 1, work[iwork:])
+
+					// Multiply right singular vectors of L in work[ir:] by Q
+					// in A, storing the result in work[iu:] chunk columns at a
+					// time and copying it to A.
+					iu := ie + m
+					chunk := min(n, (lwork-iu)/m)
+					for i := 0; i < n; i += chunk {
+						blk := min(n-i, chunk)
+						blas64.Gemm(blas.NoTrans, blas.NoTrans, m, blk, m, 1, work[ir:], ldworkr,
+							a[i:], lda, 0, work[iu:], chunk)
+						Lacpy(blas.All, m, blk, work[iu:], chunk, a[i:], lda)
+					}
+				} else {
+					// Insufficient workspace for a fast algorithm.
+					itau := 0
+					iwork := itau + m
+
+					// Compute A = L*Q.
+					Gelqf(m, n, a, lda, work[itau:], work[iwork:], lwork-iwork)
+
+					// Copy L to U, zeroing out above it.
+					Lacpy(blas.Lower, m, m, a, lda, u, ldu)
+					Laset(blas.Upper, m-1, m-1, 0, 0, u[1:], ldu)
+
+					// Generate Q in A.
+					Orglq(m, n, m, a, lda, work[itau:], work[iwork:], lwork-iwork)
+					ie = itau
+					itauq := ie + m
+					itaup := itauq + m
+					iwork = itaup + m
+
+					// Bidiagonalize L in U.
+					Gebrd(m, m, u, ldu, s, work[ie:],
+						work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+					// Multiply right vectors bidiagonalizing L by Q in A.
+					Ormbr(lapack.ApplyP, blas.Left, blas.Trans, m, n, m,
+						u, ldu, work[itaup:], a, lda, work[iwork:], lwork-iwork)
+
+					// Generate left vectors bidiagonalizing L in U.
+					Orgbr(lapack.GenerateQ, m, m, m, u, ldu,
+						work[itauq:], work[iwork:], lwork-iwork)
+					iwork = ie + m
+
+					// Perform bidiagonal QR iteration, computing left singular
+					// vectors of A in U and computing right singular vectors of
+					// A in A.
+					ok = Bdsqr(blas.Upper, m, n, m, 0, s, work[ie:], a, lda,
+						u, ldu, work, 1, work[iwork:])
+				}
+			} else if wantvs {
+				if wantun {
+					// Path 4t.
+					if lwork >= m*m+max(4*m, bdspac) {
+						// Sufficient workspace for a fast algorithm.
+						ir := 0
+						var ldworkr int
+						if lwork >= wrkbl+lda*m {
+							ldworkr = lda
+						} else {
+							ldworkr = m
+						}
+						itau := ir + ldworkr*m
+						iwork := itau + m
+
+						// Compute A = L*Q.
+						Gelqf(m, n, a, lda, work[itau:], work[iwork:], lwork-iwork)
+
+						// Copy L to work[ir:], zeroing out above it.
+						Lacpy(blas.Lower, m, m, a, lda, work[ir:], ldworkr)
+						Laset(blas.Upper, m-1, m-1, 0, 0, work[ir+1:], ldworkr)
+
+						// Generate Q in A.
+						Orglq(m, n, m, a, lda, work[itau:], work[iwork:], lwork-iwork)
+						ie := itau
+						itauq := ie + m
+						itaup := itauq + m
+						iwork = itaup + m
+
+						// Bidiagonalize L in work[ir:].
+						Gebrd(m, m, work[ir:], ldworkr, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+						// Generate right vectors bidiagonalizing L in work[ir:].
+						Orgbr(lapack.GeneratePT, m, m, m, work[ir:], ldworkr,
+							work[itaup:], work[iwork:], lwork-iwork)
+						iwork = ie + m
+
+						// Perform bidiagonal QR iteration, computing right singular
+						// vectors of L in work[ir:].
+						ok = Bdsqr(blas.Upper, m, m, 0, 0, s, work[ie:],
+							work[ir:], ldworkr, work, 1, work, 1, work[iwork:])
+
+						// Multiply right singular vectors of L in work[ir:] by
+						// Q in A, storing result in VT.
+						blas64.Gemm(blas.NoTrans, blas.NoTrans, m, n, m, 1,
+							work[ir:], ldworkr, a, lda, 0, vt, ldvt)
+					} else {
+						// Insufficient workspace for a fast algorithm.
+						itau := 0
+						iwork := itau + m
+
+						// Compute A = L*Q.
+						Gelqf(m, n, a, lda, work[itau:], work[iwork:], lwork-iwork)
+
+						// Copy result to VT.
+						Lacpy(blas.Upper, m, n, a, lda, vt, ldvt)
+
+						// Generate Q in VT.
+						Orglq(m, n, m, vt, ldvt, work[itau:], work[iwork:], lwork-iwork)
+						ie := itau
+						itauq := ie + m
+						itaup := itauq + m
+						iwork = itaup + m
+
+						// Zero out above L in A.
+						Laset(blas.Upper, m-1, m-1, 0, 0, a[1:], lda)
+
+						// Bidiagonalize L in A.
+						Gebrd(m, m, a, lda, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+						// Multiply right vectors bidiagonalizing L by Q in VT.
+						Ormbr(lapack.ApplyP, blas.Left, blas.Trans, m, n, m,
+							a, lda, work[itaup:], vt, ldvt, work[iwork:], lwork-iwork)
+						iwork = ie + m
+
+						// Perform bidiagonal QR iteration, computing right
+						// singular vectors of A in VT.
+						ok = Bdsqr(blas.Upper, m, n, 0, 0, s, work[ie:],
+							vt, ldvt, work, 1, work, 1, work[iwork:])
+					}
+				} else if wantuo {
+					// Path 5t.
+					if lwork >= 2*m*m+max(4*m, bdspac) {
+						// Sufficient workspace for a fast algorithm.
+						iu := 0
+						var ldworku, ldworkr int
+						if lwork >= wrkbl+2*lda*m {
+							ldworku = lda
+							ldworkr = lda
+						} else if lwork >= wrkbl+(lda+m)*m {
+							ldworku = lda
+							ldworkr = m
+						} else {
+							ldworku = m
+							ldworkr = m
+						}
+						ir := iu + ldworku*m
+						itau := ir + ldworkr*m
+						iwork := itau + m
+
+						// Compute A = L*Q.
+						Gelqf(m, n, a, lda, work[itau:], work[iwork:], lwork-iwork)
+
+						// Copy L to work[iu:], zeroing out above it.
+						Lacpy(blas.Lower, m, m, a, lda, work[iu:], ldworku)
+						Laset(blas.Upper, m-1, m-1, 0, 0, work[iu+1:], ldworku)
+
+						// Generate Q in A.
+						Orglq(m, n, m, a, lda, work[itau:], work[iwork:], lwork-iwork)
+						ie = itau
+						itauq := ie + m
+						itaup := itauq + m
+						iwork = itaup + m
+
+						// Bidiagonalize L in work[iu:], copying result to work[ir:].
+						Gebrd(m, m, work[iu:], ldworku, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+						Lacpy(blas.Lower, m, m, work[iu:], ldworku, work[ir:], ldworkr)
+
+						// Generate right bidiagonalizing vectors in work[iu:].
+						Orgbr(lapack.GeneratePT, m, m, m, work[iu:], ldworku,
+							work[itaup:], work[iwork:], lwork-iwork)
+
+						// Generate left bidiagonalizing vectors in work[ir:].
+						Orgbr(lapack.GenerateQ, m, m, m, work[ir:], ldworkr,
+							work[itauq:], work[iwork:], lwork-iwork)
+						iwork = ie + m
+
+						// Perform bidiagonal QR iteration, computing left singular
+						// vectors of L in work[ir:] and computing right singular
+						// vectors of L in work[iu:].
+						ok = Bdsqr(blas.Upper, m, m, m, 0, s, work[ie:], work[iu:], ldworku,
+							work[ir:], ldworkr, work, 1, work[iwork:])
+
+						// Multiply right singular vectors of L in work[iu:] by Q
+						// in A, storing result in VT.
+						blas64.Gemm(blas.NoTrans, blas.NoTrans, m, n, m, 1,
+							work[iu:], ldworku, a, lda, 0, vt, ldvt)
+
+						// Copy left singular vectors of L to A.
+						Lacpy(blas.All, m, m, work[ir:], ldworkr, a, lda)
+					} else {
+						// Insufficient workspace for a fast algorithm.
+						itau := 0
+						iwork := itau + m
+
+						// Compute A = L*Q, copying result to VT.
+						Gelqf(m, n, a, lda, work[itau:], work[iwork:], lwork-iwork)
+						Lacpy(blas.Upper, m, n, a, lda, vt, ldvt)
+
+						// Generate Q in VT.
+						Orglq(m, n, m, vt, ldvt, work[itau:], work[iwork:], lwork-iwork)
+						ie = itau
+						itauq := ie + m
+						itaup := itauq + m
+						iwork = itaup + m
+
+						// Zero out above L in A.
+						Laset(blas.Upper, m-1, m-1, 0, 0, a[1:], lda)
+
+						// Bidiagonalize L in A.
+						Gebrd(m, m, a, lda, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+						// Multiply right vectors bidiagonalizing L by Q in VT.
+						Ormbr(lapack.ApplyP, blas.Left, blas.Trans, m, n, m,
+							a, lda, work[itaup:], vt, ldvt, work[iwork:], lwork-iwork)
+
+						// Generate left bidiagonalizing vectors of L in A.
+						Orgbr(lapack.GenerateQ, m, m, m, a, lda,
+							work[itauq:], work[iwork:], lwork-iwork)
+						iwork = ie + m
+
+						// Perform bidiagonal QR iteration, computing left singular
+						// vectors of A in A and computing right singular vectors of
+						// A in VT.
+						ok = Bdsqr(blas.Upper, m, n, m, 0, s, work[ie:], vt, ldvt,
+							a, lda, work, 1, work[iwork:])
+					}
+				} else if wantuas {
+					// Path 6t.
+					if lwork >= m*m+max(4*m, bdspac) {
+						// Sufficient workspace for a fast algorithm.
+						iu := 0
+						var ldworku int
+						if lwork >= wrkbl+lda*m {
+							ldworku = lda
+						} else {
+							ldworku = m
+						}
+						itau := iu + ldworku*m
+						iwork := itau + m
+
+						// Compute A = L*Q.
+						Gelqf(m, n, a, lda, work[itau:], work[iwork:], lwork-iwork)
+
+						// Copy L to work[iu:], zeroing out above it.
+						Lacpy(blas.Lower, m, m, a, lda, work[iu:], ldworku)
+						Laset(blas.Upper, m-1, m-1, 0, 0, work[iu+1:], ldworku)
+
+						// Generate Q in A.
+						Orglq(m, n, m, a, lda, work[itau:], work[iwork:], lwork-iwork)
+						ie := itau
+						itauq := ie + m
+						itaup := itauq + m
+						iwork = itaup + m
+
+						// Bidiagonalize L in work[iu:], copying result to U.
+						Gebrd(m, m, work[iu:], ldworku, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+						Lacpy(blas.Lower, m, m, work[iu:], ldworku, u, ldu)
+
+						// Generate right bidiagionalizing vectors in work[iu:].
+						Orgbr(lapack.GeneratePT, m, m, m, work[iu:], ldworku,
+							work[itaup:], work[iwork:], lwork-iwork)
+
+						// Generate left bidiagonalizing vectors in U.
+						Orgbr(lapack.GenerateQ, m, m, m, u, ldu, work[itauq:], work[iwork:], lwork-iwork)
+						iwork = ie + m
+
+						// Perform bidiagonal QR iteration, computing left singular
+						// vectors of L in U and computing right singular vectors of
+						// L in work[iu:].
+						ok = Bdsqr(blas.Upper, m, m, m, 0, s, work[ie:],
+							work[iu:], ldworku, u, ldu, work, 1, work[iwork:])
+
+						// Multiply right singular vectors of L in work[iu:] by
+						// Q in A, storing result in VT.
+						blas64.Gemm(blas.NoTrans, blas.NoTrans, m, n, m, 1,
+							work[iu:], ldworku, a, lda, 0, vt, ldvt)
+					} else {
+						// Insufficient workspace for a fast algorithm.
+						itau := 0
+						iwork := itau + m
+
+						// Compute A = L*Q, copying result to VT.
+						Gelqf(m, n, a, lda, work[itau:], work[iwork:], lwork-iwork)
+						Lacpy(blas.Upper, m, n, a, lda, vt, ldvt)
+
+						// Generate Q in VT.
+						Orglq(m, n, m, vt, ldvt, work[itau:], work[iwork:], lwork-iwork)
+
+						// Copy L to U, zeroing out above it.
+						Lacpy(blas.Lower, m, m, a, lda, u, ldu)
+						Laset(blas.Upper, m-1, m-1, 0, 0, u[1:], ldu)
+
+						ie := itau
+						itauq := ie + m
+						itaup := itauq + m
+						iwork = itaup + m
+
+						// Bidiagonalize L in U.
+						Gebrd(m, m, u, ldu, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+						// Multiply right bidiagonalizing vectors in U by Q in VT.
+						Ormbr(lapack.ApplyP, blas.Left, blas.Trans, m, n, m,
+							u, ldu, work[itaup:], vt, ldvt, work[iwork:], lwork-iwork)
+
+						// Generate left bidiagonalizing vectors in U.
+						Orgbr(lapack.GenerateQ, m, m, m, u, ldu, work[itauq:], work[iwork:], lwork-iwork)
+						iwork = ie + m
+
+						// Perform bidiagonal QR iteration, computing left singular
+						// vectors of A in U and computing right singular vectors
+						// of A in VT.
+						ok = Bdsqr(blas.Upper, m, n, m, 0, s, work[ie:], vt, ldvt,
+							u, ldu, work, 1, work[iwork:])
+					}
+				}
+			} else if wantva {
+				if wantun {
+					// Path 7t.
+					if lwork >= m*m+max(max(n+m, 4*m), bdspac) {
+						// Sufficient workspace for a fast algorithm.
+						ir := 0
+						var ldworkr int
+						if lwork >= wrkbl+lda*m {
+							ldworkr = lda
+						} else {
+							ldworkr = m
+						}
+						itau := ir + ldworkr*m
+						iwork := itau + m
+
+						// Compute A = L*Q, copying result to VT.
+						Gelqf(m, n, a, lda, work[itau:], work[iwork:], lwork-iwork)
+						Lacpy(blas.Upper, m, n, a, lda, vt, ldvt)
+
+						// Copy L to work[ir:], zeroing out above it.
+						Lacpy(blas.Lower, m, m, a, lda, work[ir:], ldworkr)
+						Laset(blas.Upper, m-1, m-1, 0, 0, work[ir+1:], ldworkr)
+
+						// Generate Q in VT.
+						Orglq(n, n, m, vt, ldvt, work[itau:], work[iwork:], lwork-iwork)
+
+						ie := itau
+						itauq := ie + m
+						itaup := itauq + m
+						iwork = itaup + m
+
+						// Bidiagonalize L in work[ir:].
+						Gebrd(m, m, work[ir:], ldworkr, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+						// Generate right bidiagonalizing vectors in work[ir:].
+						Orgbr(lapack.GeneratePT, m, m, m, work[ir:], ldworkr,
+							work[itaup:], work[iwork:], lwork-iwork)
+						iwork = ie + m
+
+						// Perform bidiagonal QR iteration, computing right
+						// singular vectors of L in work[ir:].
+						ok = Bdsqr(blas.Upper, m, m, 0, 0, s, work[ie:],
+							work[ir:], ldworkr, work, 1, work, 1, work[iwork:])
+
+						// Multiply right singular vectors of L in work[ir:] by
+						// Q in VT, storing result in A.
+						blas64.Gemm(blas.NoTrans, blas.NoTrans, m, n, m, 1,
+							work[ir:], ldworkr, vt, ldvt, 0, a, lda)
+
+						// Copy right singular vectors of A from A to VT.
+						Lacpy(blas.All, m, n, a, lda, vt, ldvt)
+					} else {
+						// Insufficient workspace for a fast algorithm.
+						itau := 0
+						iwork := itau + m
+						// Compute A = L * Q, copying result to VT.
+						Gelqf(m, n, a, lda, work[itau:], work[iwork:], lwork-iwork)
+						Lacpy(blas.Upper, m, n, a, lda, vt, ldvt)
+
+						// Generate Q in VT.
+						Orglq(n, n, m, vt, ldvt, work[itau:], work[iwork:], lwork-iwork)
+
+						ie := itau
+						itauq := ie + m
+						itaup := itauq + m
+						iwork = itaup + m
+
+						// Zero out above L in A.
+						Laset(blas.Upper, m-1, m-1, 0, 0, a[1:], lda)
+
+						// Bidiagonalize L in A.
+						Gebrd(m, m, a, lda, s, work[ie:], work[itauq:],
+							work[itaup:], work[iwork:], lwork-iwork)
+
+						// Multiply right bidiagonalizing vectors in A by Q in VT.
+						Ormbr(lapack.ApplyP, blas.Left, blas.Trans, m, n, m,
+							a, lda, work[itaup:], vt, ldvt, work[iwork:], lwork-iwork)
+						iwork = ie + m
+
+						// Perform bidiagonal QR iteration, computing right singular
+						// vectors of A in VT.
+						ok = Bdsqr(blas.Upper, m, n, 0, 0, s, work[ie:],
+							vt, ldvt, work, 1, work, 1, work[iwork:])
+					}
+				} else if wantuo {
+					// Path 8t.
+					if lwork >= 2*m*m+max(n+m, 4*m, bdspac) {
+						// Sufficient workspace for a fast algorithm.
+						iu := 0
+						var ldworku, ldworkr int
+						if lwork >= wrkbl+2*lda*m {
+							ldworku = lda
+							ldworkr = lda
+						} else if lwork >= wrkbl+(lda+m)*m {
+							ldworku = lda
+							ldworkr = m
+						} else {
+							ldworku = m
+							ldworkr = m
+						}
+						ir := iu + ldworku*m
+						itau := ir + ldworkr*m
+						iwork := itau + m
+
+						// Compute A = L*Q, copying result to VT.
+						Gelqf(m, n, a, lda, work[itau:], work[iwork:], lwork-iwork)
+						Lacpy(blas.Upper, m, n, a, lda, vt, ldvt)
+
+						// Generate Q in VT.
+						Orglq(n, n, m, vt, ldvt, work[itau:], work[iwork:], lwork-iwork)
+
+						// Copy L to work[iu:], zeroing out above it.
+						Lacpy(blas.Lower, m, m, a, lda, work[iu:], ldworku)
+						Laset(blas.Upper, m-1, m-1, 0, 0, work[iu+1:], ldworku)
+						ie = itau
+						itauq := ie + m
+						itaup := itauq + m
+						iwork = itaup + m
+
+						// Bidiagonalize L in work[iu:], copying result to work[ir:].
+						Gebrd(m, m, work[iu:], ldworku, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+						Lacpy(blas.Lower, m, m, work[iu:], ldworku, work[ir:], ldworkr)
+
+						// Generate right bidiagonalizing vectors in work[iu:].
+						Orgbr(lapack.GeneratePT, m, m, m, work[iu:], ldworku,
+							work[itaup:], work[iwork:], lwork-iwork)
+
+						// Generate left bidiagonalizing vectors in work[ir:].
+						Orgbr(lapack.GenerateQ, m, m, m, work[ir:], ldworkr,
+							work[itauq:], work[iwork:], lwork-iwork)
+						iwork = ie + m
+
+						// Perform bidiagonal QR iteration, computing left singular
+						// vectors of L in work[ir:] and computing right singular
+						// vectors of L in work[iu:].
+						ok = Bdsqr(blas.Upper, m, m, m, 0, s, work[ie:], work[iu:], ldworku,
+							work[ir:], ldworkr, work, 1, work[iwork:])
+
+						// Multiply right singular vectors of L in work[iu:] by Q
+						// in VT, storing result in A.
+						blas64.Gemm(blas.NoTrans, blas.NoTrans, m, n, m, 1,
+							work[iu:], ldworku, vt, ldvt, 0, a, lda)
+
+						// Copy right singular vectors of A from A to VT.
+						Lacpy(blas.All, m, n, a, lda, vt, ldvt)
+
+						// Copy left singular vectors of A from work[ir:] to A.
+						Lacpy(blas.All, m, m, work[ir:], ldworkr, a, lda)
+					} else {
+						// Insufficient workspace for a fast algorithm.
+						itau := 0
+						iwork := itau + m
+
+						// Compute A = L*Q, copying result to VT.
+						Gelqf(m, n, a, lda, work[itau:], work[iwork:], lwork-iwork)
+						Lacpy(blas.Upper, m, n, a, lda, vt, ldvt)
+
+						// Generate Q in VT.
+						Orglq(n, n, m, vt, ldvt, work[itau:], work[iwork:], lwork-iwork)
+						ie = itau
+						itauq := ie + m
+						itaup := itauq + m
+						iwork = itaup + m
+
+						// Zero out above L in A.
+						Laset(blas.Upper, m-1, m-1, 0, 0, a[1:], lda)
+
+						// Bidiagonalize L in A.
+						Gebrd(m, m, a, lda, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+
+						// Multiply right bidiagonalizing vectors in A by Q in VT.
+						Ormbr(lapack.ApplyP, blas.Left, blas.Trans, m, n, m,
+							a, lda, work[itaup:], vt, ldvt, work[iwork:], lwork-iwork)
+
+						// Generate left bidiagonalizing vectors in A.
+						Orgbr(lapack.GenerateQ, m, m, m, a, lda,
+							work[itauq:], work[iwork:], lwork-iwork)
+						iwork = ie + m
+
+						// Perform bidiagonal QR iteration, computing left singular
+						// vectors of A in A and computing right singular vectors of
+						// A in VT.
+						ok = Bdsqr(blas.Upper, m, n, m, 0, s, work[ie:], vt, ldvt,
+							a, lda, work, 1, work[iwork:])
+					}
+				} else if wantuas {
+					// Path 9t.
+					if lwork >= m*m+max(max(m+n, 4*m), bdspac) {
+						// Sufficient workspace for a fast algorithm.
+						iu := 0
+
+						var ldworku int
+						if lwork >= wrkbl+lda*m {
+							ldworku = lda
+						} else {
+							ldworku = m
+						}
+						itau := iu + ldworku*m
+						iwork := itau + m
+
+						// Generate A = L * Q copying result to VT.
+						Gelqf(m, n, a, lda, work[itau:], work[iwork:], lwork-iwork)
+						Lacpy(blas.Upper, m, n, a, lda, vt, ldvt)
+
+						// Generate Q in VT.
+						Orglq(n, n, m, vt, ldvt, work[itau:], work[iwork:], lwork-iwork)
+
+						// Copy L to work[iu:], zeroing out above it.
+						Lacpy(blas.Lower, m, m, a, lda, work[iu:], ldworku)
+						Laset(blas.Upper, m-1, m-1, 0, 0, work[iu+1:], ldworku)
+						ie = itau
+						itauq := ie + m
+						itaup := itauq + m
+						iwork = itaup + m
+
+						// Bidiagonalize L in work[iu:], copying result to U.
+						Gebrd(m, m, work[iu:], ldworku, s, work[ie:],
+							work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+						Lacpy(blas.Lower, m, m, work[iu:], ldworku, u, ldu)
+
+						// Generate right bidiagonalizing vectors in work[iu:].
+						Orgbr(lapack.GeneratePT, m, m, m, work[iu:], ldworku,
+							work[itaup:], work[iwork:], lwork-iwork)
+
+						// Generate left bidiagonalizing vectors in U.
+						Orgbr(lapack.GenerateQ, m, m, m, u, ldu, work[itauq:], work[iwork:], lwork-iwork)
+						iwork = ie + m
+
+						// Perform bidiagonal QR iteration, computing left singular
+						// vectors of L in U and computing right singular vectors
+						// of L in work[iu:].
+						ok = Bdsqr(blas.Upper, m, m, m, 0, s, work[ie:],
+							work[iu:], ldworku, u, ldu, work, 1, work[iwork:])
+
+						// Multiply right singular vectors of L in work[iu:]
+						// Q in VT, storing result in A.
+						blas64.Gemm(blas.NoTrans, blas.NoTrans, m, n, m, 1,
+							work[iu:], ldworku, vt, ldvt, 0, a, lda)
+
+						// Copy right singular vectors of A from A to VT.
+						Lacpy(blas.All, m, n, a, lda, vt, ldvt)
+					} else {
+						// Insufficient workspace for a fast algorithm.
+						itau := 0
+						iwork := itau + m
+
+						// Compute A = L * Q, copying result to VT.
+						Gelqf(m, n, a, lda, work[itau:], work[iwork:], lwork-iwork)
+						Lacpy(blas.Upper, m, n, a, lda, vt, ldvt)
+
+						// Generate Q in VT.
+						Orglq(n, n, m, vt, ldvt, work[itau:], work[iwork:], lwork-iwork)
+
+						// Copy L to U, zeroing out above it.
+						Lacpy(blas.Lower, m, m, a, lda, u, ldu)
+						Laset(blas.Upper, m-1, m-1, 0, 0, u[1:], ldu)
+
+						ie = itau
+						itauq := ie + m
+						itaup := itauq + m
+						iwork = itaup + m
+
+						// Bidiagonalize L in U.
+						Gebrd(m, m, u, ldu, s, work[ie:], work[itauq:],
+							work[itaup:], work[iwork:], lwork-iwork)
+
+						// Multiply right bidiagonalizing vectors in U by Q in VT.
+						Ormbr(lapack.ApplyP, blas.Left, blas.Trans, m, n, m,
+							u, ldu, work[itaup:], vt, ldvt, work[iwork:], lwork-iwork)
+
+						// Generate left bidiagonalizing vectors in U.
+						Orgbr(lapack.GenerateQ, m, m, m, u, ldu, work[itauq:], work[iwork:], lwork-iwork)
+						iwork = ie + m
+
+						// Perform bidiagonal QR iteration, computing left singular
+						// vectors of A in U and computing right singular vectors
+						// of A in VT.
+						ok = Bdsqr(blas.Upper, m, n, m, 0, s, work[ie:],
+							vt, ldvt, u, ldu, work, 1, work[iwork:])
+					}
+				}
+			}
+		} else {
+			// Path 10t.
+			// N at least M, but not much larger.
+			ie = 0
+			itauq := ie + m
+			itaup := itauq + m
+			iwork := itaup + m
+
+			// Bidiagonalize A.
+			Gebrd(m, n, a, lda, s, work[ie:], work[itauq:], work[itaup:], work[iwork:], lwork-iwork)
+			if wantuas {
+				// If left singular vectors desired in U, copy result to U and
+				// generate left bidiagonalizing vectors in U.
+				Lacpy(blas.Lower, m, m, a, lda, u, ldu)
+				Orgbr(lapack.GenerateQ, m, m, n, u, ldu, work[itauq:], work[iwork:], lwork-iwork)
+			}
+			if wantvas {
+				// If right singular vectors desired in VT, copy result to VT
+				// and generate right bidiagonalizing vectors in VT.
+				Lacpy(blas.Upper, m, n, a, lda, vt, ldvt)
+				var nrvt int
+				if wantva {
+					nrvt = n
+				} else {
+					nrvt = m
+				}
+				Orgbr(lapack.GeneratePT, nrvt, n, m, vt, ldvt, work[itaup:], work[iwork:], lwork-iwork)
+			}
+			if wantuo {
+				// Left singular vectors are desired in A. Generate left
+				// bidiagonalizing vectors in A.
+				Orgbr(lapack.GenerateQ, m, m, n, a, lda, work[itauq:], work[iwork:], lwork-iwork)
+			}
+			if wantvo {
+				// Right singular vectors are desired in A. Generate right
+				// bidiagonalizing vectors in A.
+				Orgbr(lapack.GeneratePT, m, n, m, a, lda, work[itaup:], work[iwork:], lwork-iwork)
+			}
+			iwork = ie + m
+			var nru, ncvt int
+			if wantuas || wantuo {
+				nru = m
+			}
+			if wantvas || wantvo {
+				ncvt = n
+			}
+			if !wantuo && !wantvo {
+				// Perform bidiagonal QR iteration, if desired, computing left
+				// singular vectors in U and computing right singular vectors in
+				// VT.
+				ok = Bdsqr(blas.Lower, m, ncvt, nru, 0, s, work[ie:],
+					vt, ldvt, u, ldu, work, 1, work[iwork:])
+			} else if !wantuo && wantvo {
+				// Perform bidiagonal QR iteration, if desired, computing left
+				// singular vectors in U and computing right singular vectors in
+				// A.
+				ok = Bdsqr(blas.Lower, m, ncvt, nru, 0, s, work[ie:],
+					a, lda, u, ldu, work, 1, work[iwork:])
+			} else {
+				// Perform bidiagonal QR iteration, if desired, computing left
+				// singular vectors in A and computing right singular vectors in
+				// VT.
+				ok = Bdsqr(blas.Lower, m, ncvt, nru, 0, s, work[ie:],
+					vt, ldvt, a, lda, work, 1, work[iwork:])
+			}
+		}
+	}
+	if !ok {
+		if ie > 1 {
+			for i := 0; i < minmn-1; i++ {
+				work[i+1] = work[i+ie]
+			}
+		}
+		if ie < 1 {
+			for i := minmn - 2; i >= 0; i-- {
+				work[i+1] = work[i+ie]
+			}
+		}
+	}
+	// Undo scaling if necessary.
+	if iscl {
+		if anrm > bignum {
+			Lascl(lapack.General, 0, 0, bignum, anrm, 1, minmn, s, minmn)
+		}
+		if !ok && anrm > bignum {
+			Lascl(lapack.General, 0, 0, bignum, anrm, 1, minmn-1, work[1:], minmn)
+		}
+		if anrm < smlnum {
+			Lascl(lapack.General, 0, 0, smlnum, anrm, 1, minmn, s, minmn)
+		}
+		if !ok && anrm < smlnum {
+			Lascl(lapack.General, 0, 0, smlnum, anrm, 1, minmn-1, work[1:], minmn)
+		}
+	}
+	work[0] = float64(maxwrk)
+	return ok
+}
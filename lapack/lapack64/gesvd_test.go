@@ -0,0 +1,152 @@
+package lapack64
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/lapack"
+)
+
+func TestGesvd(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	jobs := []lapack.SVDJob{lapack.SVDAll, lapack.SVDStore, lapack.SVDOverwrite, lapack.SVDNone}
+	for _, dims := range [][2]int{
+		{0, 0}, {1, 1}, {7, 1}, {1, 7}, {5, 3}, {3, 5}, {7, 5}, {5, 7}, {10, 10},
+		{150, 40}, {40, 150}, {100, 100},
+	} {
+		m, n := dims[0], dims[1]
+		k := min(m, n)
+		lda := n + 3
+		a := randomGeneral(m, n, lda, rnd)
+
+		// Compute the reference singular values without singular vectors.
+		sWant := make([]float64, k)
+		work := optimalWork(func(work []float64, lwork int) {
+			Gesvd(lapack.SVDNone, lapack.SVDNone, m, n, a, lda, sWant, nil, 1, nil, 1, work, lwork)
+		})
+		if !Gesvd(lapack.SVDNone, lapack.SVDNone, m, n, append([]float64(nil), a...), lda, sWant, nil, 1, nil, 1, work, len(work)) {
+			t.Errorf("m=%d,n=%d: no convergence", m, n)
+			continue
+		}
+
+		for _, jobU := range jobs {
+			for _, jobVT := range jobs {
+				if jobU == lapack.SVDOverwrite && jobVT == lapack.SVDOverwrite {
+					continue
+				}
+				ucols, vrows := k, k
+				if jobU == lapack.SVDAll {
+					ucols = m
+				}
+				if jobVT == lapack.SVDAll {
+					vrows = n
+				}
+				ldu, ldvt := max(1, ucols)+1, max(1, n)+2
+				optwork := optimalWork(func(work []float64, lwork int) {
+					Gesvd(jobU, jobVT, m, n, a, lda, nil, nil, ldu, nil, ldvt, work, lwork)
+				})
+				minwork := 1
+				if k > 0 {
+					minwork = max(3*k+max(m, n), 5*k)
+				}
+				// Exercise the slow, fast and chunked paths.
+				lworks := []int{
+					minwork,
+					max(minwork, k*k+5*k),
+					max(minwork, 2*k*k+max(m, n)+5*k),
+					len(optwork),
+					len(optwork) + (lda-k)*k,
+					len(optwork) + 2*lda*k,
+				}
+				for _, lwork := range lworks {
+					name := fmt.Sprintf("m=%d,n=%d,jobU=%c,jobVT=%c,lwork=%d", m, n, jobU, jobVT, lwork)
+					aCopy := append([]float64(nil), a...)
+					s := make([]float64, k)
+					u := make([]float64, max(1, m*ldu))
+					vt := make([]float64, max(1, vrows*ldvt))
+					work := make([]float64, lwork)
+					if !Gesvd(jobU, jobVT, m, n, aCopy, lda, s, u, ldu, vt, ldvt, work, lwork) {
+						t.Errorf("%s: no convergence", name)
+						continue
+					}
+					for i := 0; i < k; i++ {
+						if math.Abs(s[i]-sWant[i]) > 1e-13*float64(max(m, n))*sWant[0] {
+							t.Errorf("%s: singular values %v, want %v", name, s, sWant)
+							break
+						}
+					}
+
+					// Take the singular vectors from a if they overwrite it.
+					if jobU == lapack.SVDOverwrite {
+						u, ldu = aCopy, lda
+					}
+					if jobVT == lapack.SVDOverwrite {
+						vt, ldvt = aCopy, lda
+					}
+					hasU := jobU != lapack.SVDNone
+					hasVT := jobVT != lapack.SVDNone
+					if hasU {
+						checkOrthogonal(t, name+": U", m, ucols, u, ldu, false)
+					}
+					if hasVT {
+						checkOrthogonal(t, name+": Vᵀ", vrows, n, vt, ldvt, true)
+					}
+					switch {
+					case hasU && hasVT:
+						// Scale the first k columns of U by the singular values.
+						us := make([]float64, m*k)
+						for i := 0; i < m; i++ {
+							for j := 0; j < k; j++ {
+								us[i*k+j] = u[i*ldu+j] * s[j]
+							}
+						}
+						usvt := mul(blas.NoTrans, blas.NoTrans, m, n, k, us, max(1, k), vt, ldvt)
+						checkResidual(t, name+": A != U*Σ*Vᵀ", m, n, a, lda, usvt, max(1, n), max(m, n), 10)
+					case hasU:
+						// The rows of Uᵀ*A = Σ*Vᵀ have norms equal to the singular values.
+						uta := mul(blas.Trans, blas.NoTrans, k, n, m, u, ldu, a, lda)
+						checkNorms(t, name+": Uᵀ*A", k, n, uta, max(1, n), s, false)
+					case hasVT:
+						// The columns of A*V = U*Σ have norms equal to the singular values.
+						av := mul(blas.NoTrans, blas.Trans, m, k, n, a, lda, vt, ldvt)
+						checkNorms(t, name+": A*V", m, k, av, max(1, k), s, true)
+					}
+				}
+			}
+		}
+	}
+}
+
+// checkNorms reports an error if the rows, or columns if cols is true, of the
+// m×n matrix b with row stride ldb do not have norms equal to s.
+func checkNorms(t *testing.T, name string, m, n int, b []float64, ldb int, s []float64, cols bool) {
+	t.Helper()
+	for i, want := range s {
+		var norm float64
+		if cols {
+			for j := 0; j < m; j++ {
+				norm = math.Hypot(norm, b[j*ldb+i])
+			}
+		} else {
+			for j := 0; j < n; j++ {
+				norm = math.Hypot(norm, b[i*ldb+j])
+			}
+		}
+		if math.Abs(norm-want) > 1e-12*float64(max(m, n))*s[0] {
+			t.Errorf("%s: norm %d is %v, want %v", name, i, norm, want)
+		}
+	}
+}
+
+func TestGesvdOverwritePanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r != lapack.ErrBothSVDOver {
+			t.Errorf("got panic %v, want %q", r, lapack.ErrBothSVDOver)
+		}
+	}()
+	work := make([]float64, 100)
+	Gesvd(lapack.SVDOverwrite, lapack.SVDOverwrite, 4, 4, make([]float64, 16), 4, make([]float64, 4), nil, 1, nil, 1, work, len(work))
+}
@@ -0,0 +1,127 @@
+package lapack64
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+// luProduct returns P*L*U for the LU factorization of an m×n matrix computed
+// by Getrf, with row stride n.
+func luProduct(m, n int, a []float64, lda int, ipiv []int) []float64 {
+	k := min(m, n)
+	l := make([]float64, m*k)
+	for i := 0; i < m; i++ {
+		for j := 0; j < min(i, k); j++ {
+			l[i*k+j] = a[i*lda+j]
+		}
+		if i < k {
+			l[i*k+i] = 1
+		}
+	}
+	u := make([]float64, k*n)
+	for i := 0; i < k; i++ {
+		copy(u[i*n+i:i*n+n], a[i*lda+i:i*lda+n])
+	}
+	lu := mul(blas.NoTrans, blas.NoTrans, m, n, k, l, max(1, k), u, max(1, n))
+	for i := k - 1; i >= 0; i-- {
+		if p := ipiv[i]; p != i {
+			for j := 0; j < n; j++ {
+				lu[i*n+j], lu[p*n+j] = lu[p*n+j], lu[i*n+j]
+			}
+		}
+	}
+	return lu
+}
+
+func TestGetrf(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{
+		{0, 0}, {1, 1}, {5, 3}, {3, 5}, {10, 10},
+		{150, 150}, {200, 130}, {130, 200},
+	} {
+		m, n := dims[0], dims[1]
+		for _, extra := range []int{0, 3} {
+			lda := max(1, n) + extra
+			a := randomGeneral(m, n, lda, rnd)
+			aCopy := cloneGeneral(m, n, a, lda)
+			ipiv := make([]int, min(m, n))
+			ok := Getrf(m, n, a, lda, ipiv)
+			name := fmt.Sprintf("m=%d,n=%d,lda=%d", m, n, lda)
+			if !ok {
+				t.Errorf("%s: unexpected singular matrix", name)
+				continue
+			}
+			lu := luProduct(m, n, a, lda, ipiv)
+			checkResidual(t, name+": A != P*L*U", m, n, aCopy, max(1, n), lu, max(1, n), max(m, n), 10)
+		}
+	}
+}
+
+func TestGetrfSingular(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 4, 150} {
+		a := randomGeneral(n, n, n, rnd)
+		// A zero column stays exactly zero during the elimination.
+		for i := 0; i < n; i++ {
+			a[i*n+n/2] = 0
+		}
+		if Getrf(n, n, a, n, make([]int, n)) {
+			t.Errorf("n=%d: singular matrix not detected", n)
+		}
+	}
+}
+
+func TestGetrs(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 5, 40, 150} {
+		for _, nrhs := range []int{1, 3} {
+			for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				lda, ldb := max(1, n)+2, nrhs+1
+				a := randomGeneral(n, n, lda, rnd)
+				aCopy := cloneGeneral(n, n, a, lda)
+				b := randomGeneral(n, nrhs, ldb, rnd)
+				bCopy := cloneGeneral(n, nrhs, b, ldb)
+				ipiv := make([]int, n)
+				if !Getrf(n, n, a, lda, ipiv) {
+					t.Fatalf("n=%d: unexpected singular matrix", n)
+				}
+				Getrs(trans, n, nrhs, a, lda, ipiv, b, ldb)
+
+				ax := mul(trans, blas.NoTrans, n, nrhs, n, aCopy, max(1, n), b, ldb)
+				name := fmt.Sprintf("n=%d,nrhs=%d,trans=%c", n, nrhs, trans)
+				checkResidual(t, name+": A*X != B", n, nrhs, bCopy, nrhs, ax, nrhs, n, 1000)
+			}
+		}
+	}
+}
+
+func TestGetri(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 5, 40, 150} {
+		for _, wl := range []string{"min", "opt"} {
+			lda := max(1, n) + 3
+			a := randomGeneral(n, n, lda, rnd)
+			aCopy := cloneGeneral(n, n, a, lda)
+			ipiv := make([]int, n)
+			if !Getrf(n, n, a, lda, ipiv) {
+				t.Fatalf("n=%d: unexpected singular matrix", n)
+			}
+			lwork := max(1, n)
+			if wl == "opt" {
+				work := make([]float64, 1)
+				Getri(n, a, lda, ipiv, work, -1)
+				lwork = int(work[0])
+			}
+			name := fmt.Sprintf("n=%d,lwork=%s", n, wl)
+			if !Getri(n, a, lda, ipiv, make([]float64, lwork), lwork) {
+				t.Errorf("%s: unexpected singular matrix", name)
+				continue
+			}
+			ainv := mul(blas.NoTrans, blas.NoTrans, n, n, n, aCopy, max(1, n), a, lda)
+			checkResidual(t, name+": A*inv(A) != I", n, n, eye(n, max(1, n)), max(1, n), ainv, max(1, n), n, 1000)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package lapack64
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// gsvdFactors returns the matrices D1, D2 and [ 0 R ] of the GSVD computed by
+// Ggsvd3, with row strides k+l, k+l and n.
+func gsvdFactors(m, p, n, k, l int, a []float64, lda int, b []float64, ldb int, alpha, beta []float64) (d1, d2, zeroR []float64) {
+	r := k + l
+	d1 = make([]float64, m*r)
+	for i := 0; i < min(m, r); i++ {
+		d1[i*r+i] = alpha[i]
+	}
+	d2 = make([]float64, p*r)
+	for i := k; i < r; i++ {
+		d2[(i-k)*r+i] = beta[i]
+	}
+	// R is upper triangular with its first rows stored in A and, if m < k+l,
+	// its last rows stored in B.
+	zeroR = make([]float64, r*n)
+	for i := 0; i < r; i++ {
+		for j := n - r + i; j < n; j++ {
+			if i < m {
+				zeroR[i*n+j] = a[i*lda+j]
+			} else {
+				zeroR[i*n+j] = b[(i-k)*ldb+j]
+			}
+		}
+	}
+	return d1, d2, zeroR
+}
+
+func TestGgsvd3(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][3]int{
+		{1, 1, 1}, {3, 3, 3}, {5, 4, 3}, {3, 5, 6}, {2, 6, 5},
+		{10, 10, 10}, {20, 15, 10}, {10, 8, 20}, {4, 30, 25}, {3, 2, 12},
+	} {
+		m, p, n := dims[0], dims[1], dims[2]
+		lda, ldb := n+2, n+1
+		ldu, ldv, ldq := m+1, p+2, n+3
+		a := randomGeneral(m, n, lda, rnd)
+		b := randomGeneral(p, n, ldb, rnd)
+		aCopy := cloneGeneral(m, n, a, lda)
+		bCopy := cloneGeneral(p, n, b, ldb)
+		alpha, beta := make([]float64, n), make([]float64, n)
+		u := make([]float64, m*ldu)
+		v := make([]float64, p*ldv)
+		q := make([]float64, n*ldq)
+		iwork := make([]int, n)
+		work := optimalWork(func(work []float64, lwork int) {
+			Ggsvd3(lapack.GSVDU, lapack.GSVDV, lapack.GSVDQ, m, n, p, a, lda, b, ldb, alpha, beta, u, ldu, v, ldv, q, ldq, work, lwork, iwork)
+		})
+		k, l, ok := Ggsvd3(lapack.GSVDU, lapack.GSVDV, lapack.GSVDQ, m, n, p, a, lda, b, ldb, alpha, beta, u, ldu, v, ldv, q, ldq, work, len(work), iwork)
+		name := fmt.Sprintf("m=%d,p=%d,n=%d", m, p, n)
+		if !ok {
+			t.Errorf("%s: no convergence", name)
+			continue
+		}
+		// Random matrices have full rank.
+		if k+l != min(m+p, n) {
+			t.Errorf("%s: effective rank k+l=%d, want %d", name, k+l, min(m+p, n))
+			continue
+		}
+		for i := k; i < k+l; i++ {
+			if d := alpha[i]*alpha[i] + beta[i]*beta[i]; math.Abs(d-1) > 1e-14 {
+				t.Errorf("%s: alpha[%d]²+beta[%d]² = %v, want 1", name, i, i, d)
+			}
+		}
+
+		checkOrthogonal(t, name+": U", m, m, u, ldu, false)
+		checkOrthogonal(t, name+": V", p, p, v, ldv, false)
+		checkOrthogonal(t, name+": Q", n, n, q, ldq, false)
+		d1, d2, zeroR := gsvdFactors(m, p, n, k, l, a, lda, b, ldb, alpha, beta)
+		r := k + l
+
+		aq := mul(blas.NoTrans, blas.NoTrans, m, n, n, aCopy, n, q, ldq)
+		uaq := mul(blas.Trans, blas.NoTrans, m, n, m, u, ldu, aq, n)
+		d1r := mul(blas.NoTrans, blas.NoTrans, m, n, r, d1, r, zeroR, n)
+		checkResidual(t, name+": Uᵀ*A*Q != D1*[ 0 R ]", m, n, uaq, n, d1r, n, max(m, p, n), 10)
+
+		bq := mul(blas.NoTrans, blas.NoTrans, p, n, n, bCopy, n, q, ldq)
+		vbq := mul(blas.Trans, blas.NoTrans, p, n, p, v, ldv, bq, n)
+		d2r := mul(blas.NoTrans, blas.NoTrans, p, n, r, d2, r, zeroR, n)
+		checkResidual(t, name+": Vᵀ*B*Q != D2*[ 0 R ]", p, n, vbq, n, d2r, n, max(m, p, n), 10)
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack64
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Implementation is the LAPACK implementation provided by this package. Its
+// methods call the package level functions of the same name, so it can be
+// passed wherever a lapack.Float64 is expected.
+type Implementation struct{}
+
+var _ lapack.Float64 = Implementation{}
+
+// Gecon estimates and returns the reciprocal of the condition number of the n×n
+// matrix A, in either the 1-norm or the ∞-norm, using the LU factorization
+// computed by Getrf.
+func (Implementation) Gecon(norm lapack.MatrixNorm, n int, a []float64, lda int, anorm float64, work []float64, iwork []int) float64 {
+	return Gecon(norm, n, a, lda, anorm, work, iwork)
+}
+
+// Geev computes the eigenvalues and, optionally, the left and/or right
+// eigenvectors for an n×n real nonsymmetric matrix A.
+func (Implementation) Geev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []float64, lda int, wr, wi []float64, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) (first int) {
+	return Geev(jobvl, jobvr, n, a, lda, wr, wi, vl, ldvl, vr, ldvr, work, lwork)
+}
+
+// Gels finds a minimum-norm solution based on the matrices A and B using the
+// QR or LQ factorization.
+func (Implementation) Gels(trans blas.Transpose, m, n, nrhs int, a []float64, lda int, b []float64, ldb int, work []float64, lwork int) bool {
+	return Gels(trans, m, n, nrhs, a, lda, b, ldb, work, lwork)
+}
+
+// Gelqf computes the LQ factorization of the m×n matrix A using a blocked
+// algorithm.
+func (Implementation) Gelqf(m, n int, a []float64, lda int, tau, work []float64, lwork int) {
+	Gelqf(m, n, a, lda, tau, work, lwork)
+}
+
+// Geqp3 computes a QR factorization with column pivoting of the m×n matrix A.
+func (Implementation) Geqp3(m, n int, a []float64, lda int, jpvt []int, tau, work []float64, lwork int) {
+	Geqp3(m, n, a, lda, jpvt, tau, work, lwork)
+}
+
+// Geqrf computes the QR factorization of the m×n matrix A using a blocked
+// algorithm.
+func (Implementation) Geqrf(m, n int, a []float64, lda int, tau, work []float64, lwork int) {
+	Geqrf(m, n, a, lda, tau, work, lwork)
+}
+
+// Gesvd computes the singular value decomposition of the input matrix A.
+func (Implementation) Gesvd(jobU, jobVT lapack.SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int) (ok bool) {
+	return Gesvd(jobU, jobVT, m, n, a, lda, s, u, ldu, vt, ldvt, work, lwork)
+}
+
+// Getrf computes the LU decomposition of an m×n matrix A using partial pivoting
+// with row interchanges.
+func (Implementation) Getrf(m, n int, a []float64, lda int, ipiv []int) (ok bool) {
+	return Getrf(m, n, a, lda, ipiv)
+}
+
+// Getri computes the inverse of the matrix A using the LU factorization
+// computed by Getrf.
+func (Implementation) Getri(n int, a []float64, lda int, ipiv []int, work []float64, lwork int) (ok bool) {
+	return Getri(n, a, lda, ipiv, work, lwork)
+}
+
+// Getrs solves a system of equations using an LU factorization.
+func (Implementation) Getrs(trans blas.Transpose, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int) {
+	Getrs(trans, n, nrhs, a, lda, ipiv, b, ldb)
+}
+
+// Ggsvd3 computes the generalized singular value decomposition (GSVD) of an m×n
+// matrix A and p×n matrix B.
+func (Implementation) Ggsvd3(jobU, jobV, jobQ lapack.GSVDJob, m, n, p int, a []float64, lda int, b []float64, ldb int, alpha, beta, u []float64, ldu int, v []float64, ldv int, q []float64, ldq int, work []float64, lwork int, iwork []int) (k, l int, ok bool) {
+	return Ggsvd3(jobU, jobV, jobQ, m, n, p, a, lda, b, ldb, alpha, beta, u, ldu, v, ldv, q, ldq, work, lwork, iwork)
+}
+
+// Lantr computes the specified norm of an m×n trapezoidal matrix A.
+func (Implementation) Lantr(norm lapack.MatrixNorm, uplo blas.Uplo, diag blas.Diag, m, n int, a []float64, lda int, work []float64) float64 {
+	return Lantr(norm, uplo, diag, m, n, a, lda, work)
+}
+
+// Lange returns the value of the specified norm of a general m×n matrix A.
+func (Implementation) Lange(norm lapack.MatrixNorm, m, n int, a []float64, lda int, work []float64) float64 {
+	return Lange(norm, m, n, a, lda, work)
+}
+
+// Lansy returns the value of the specified norm of an n×n symmetric matrix.
+func (Implementation) Lansy(norm lapack.MatrixNorm, uplo blas.Uplo, n int, a []float64, lda int, work []float64) float64 {
+	return Lansy(norm, uplo, n, a, lda, work)
+}
+
+// Lapmr rearranges the rows of the m×n matrix X as specified by the permutation
+// k[0],k[1],...,k[m-1] of the integers 0,...,m-1.
+func (Implementation) Lapmr(forward bool, m, n int, x []float64, ldx int, k []int) {
+	Lapmr(forward, m, n, x, ldx, k)
+}
+
+// Lapmt rearranges the columns of the m×n matrix X as specified by the
+// permutation k_0, k_1, ..., k_n-1 of the integers 0, ..., n-1.
+func (Implementation) Lapmt(forward bool, m, n int, x []float64, ldx int, k []int) {
+	Lapmt(forward, m, n, x, ldx, k)
+}
+
+// Orgqr generates an m×n matrix Q with orthonormal columns defined by the
+// product of elementary reflectors.
+func (Implementation) Orgqr(m, n, k int, a []float64, lda int, tau, work []float64, lwork int) {
+	Orgqr(m, n, k, a, lda, tau, work, lwork)
+}
+
+// Ormqr multiplies an m×n matrix C by the orthogonal matrix Q from a QR
+// factorization.
+func (Implementation) Ormqr(side blas.Side, trans blas.Transpose, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) {
+	Ormqr(side, trans, m, n, k, a, lda, tau, c, ldc, work, lwork)
+}
+
+// Orglq generates an m×n matrix Q with orthonormal rows defined as the first m
+// rows of a product of k elementary reflectors of order n.
+func (Implementation) Orglq(m, n, k int, a []float64, lda int, tau, work []float64, lwork int) {
+	Orglq(m, n, k, a, lda, tau, work, lwork)
+}
+
+// Ormlq multiplies the matrix C by the orthogonal matrix Q defined by the
+// slices a and tau.
+func (Implementation) Ormlq(side blas.Side, trans blas.Transpose, m, n, k int, a []float64, lda int, tau, c []float64, ldc int, work []float64, lwork int) {
+	Ormlq(side, trans, m, n, k, a, lda, tau, c, ldc, work, lwork)
+}
+
+// Pbcon returns an estimate of the reciprocal of the condition number (in the
+// 1-norm) of an n×n symmetric positive definite band matrix using the Cholesky
+// factorization.
+func (Implementation) Pbcon(uplo blas.Uplo, n, kd int, ab []float64, ldab int, anorm float64, work []float64, iwork []int) (rcond float64) {
+	return Pbcon(uplo, n, kd, ab, ldab, anorm, work, iwork)
+}
+
+// Pbtrf computes the Cholesky factorization of an n×n symmetric positive
+// definite band matrix.
+func (Implementation) Pbtrf(uplo blas.Uplo, n, kd int, ab []float64, ldab int) (ok bool) {
+	return Pbtrf(uplo, n, kd, ab, ldab)
+}
+
+// Pbtrs solves a system of linear equations A*X = B with an n×n symmetric
+// positive definite band matrix A using the Cholesky factorization.
+func (Implementation) Pbtrs(uplo blas.Uplo, n, kd, nrhs int, ab []float64, ldab int, b []float64, ldb int) {
+	Pbtrs(uplo, n, kd, nrhs, ab, ldab, b, ldb)
+}
+
+// Pocon estimates the reciprocal of the condition number of a positive-definite
+// matrix A given the Cholesky decomposition of A.
+func (Implementation) Pocon(uplo blas.Uplo, n int, a []float64, lda int, anorm float64, work []float64, iwork []int) float64 {
+	return Pocon(uplo, n, a, lda, anorm, work, iwork)
+}
+
+// Potrf computes the Cholesky decomposition of the symmetric positive definite
+// matrix a.
+func (Implementation) Potrf(ul blas.Uplo, n int, a []float64, lda int) (ok bool) {
+	return Potrf(ul, n, a, lda)
+}
+
+// Potri computes the inverse of a real symmetric positive definite matrix A
+// using its Cholesky factorization.
+func (Implementation) Potri(uplo blas.Uplo, n int, a []float64, lda int) (ok bool) {
+	return Potri(uplo, n, a, lda)
+}
+
+// Potrs solves a system of n linear equations A*X = B where A is an n×n
+// symmetric positive definite matrix and B is an n×nrhs matrix.
+func (Implementation) Potrs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, b []float64, ldb int) {
+	Potrs(uplo, n, nrhs, a, lda, b, ldb)
+}
+
+// Pstrf computes the Cholesky factorization with complete pivoting of an n×n
+// symmetric positive semidefinite matrix A.
+func (Implementation) Pstrf(uplo blas.Uplo, n int, a []float64, lda int, piv []int, tol float64, work []float64) (rank int, ok bool) {
+	return Pstrf(uplo, n, a, lda, piv, tol, work)
+}
+
+// Syev computes all eigenvalues and, optionally, the eigenvectors of a real
+// symmetric matrix A.
+func (Implementation) Syev(jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int) (ok bool) {
+	return Syev(jobz, uplo, n, a, lda, w, work, lwork)
+}
+
+// Tbtrs solves a triangular system A * X = B or Aᵀ * X = B with an n×n
+// triangular band matrix A.
+func (Implementation) Tbtrs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, n, kd, nrhs int, a []float64, lda int, b []float64, ldb int) (ok bool) {
+	return Tbtrs(uplo, trans, diag, n, kd, nrhs, a, lda, b, ldb)
+}
+
+// Trcon estimates the reciprocal of the condition number of a triangular
+// matrix A.
+func (Implementation) Trcon(norm lapack.MatrixNorm, uplo blas.Uplo, diag blas.Diag, n int, a []float64, lda int, work []float64, iwork []int) float64 {
+	return Trcon(norm, uplo, diag, n, a, lda, work, iwork)
+}
+
+// Trtri computes the inverse of a triangular matrix, storing the result in
+// place into a.
+func (Implementation) Trtri(uplo blas.Uplo, diag blas.Diag, n int, a []float64, lda int) (ok bool) {
+	return Trtri(uplo, diag, n, a, lda)
+}
+
+// Trtrs solves a triangular system of the form A * X = B or Aᵀ * X = B.
+func (Implementation) Trtrs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, n, nrhs int, a []float64, lda int, b []float64, ldb int) (ok bool) {
+	return Trtrs(uplo, trans, diag, n, nrhs, a, lda, b, ldb)
+}
@@ -0,0 +1,168 @@
+package lapack64
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/lapack"
+)
+
+var matrixNorms = []lapack.MatrixNorm{lapack.MaxAbs, lapack.MaxColumnSum, lapack.MaxRowSum, lapack.Frobenius}
+
+// naiveNorm returns the specified norm of the m×n matrix a with row stride
+// lda computed directly from its definition.
+func naiveNorm(norm lapack.MatrixNorm, m, n int, a []float64, lda int) float64 {
+	var v float64
+	switch norm {
+	case lapack.MaxAbs:
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				v = max(v, math.Abs(a[i*lda+j]))
+			}
+		}
+	case lapack.MaxColumnSum:
+		v = oneNorm(m, n, a, lda)
+	case lapack.MaxRowSum:
+		for i := 0; i < m; i++ {
+			var sum float64
+			for j := 0; j < n; j++ {
+				sum += math.Abs(a[i*lda+j])
+			}
+			v = max(v, sum)
+		}
+	case lapack.Frobenius:
+		v = frobenius(m, n, a, lda)
+	}
+	return v
+}
+
+// checkNorm reports an error if got and want differ by more than a small
+// relative tolerance.
+func checkNorm(t *testing.T, name string, got, want float64) {
+	t.Helper()
+	if math.Abs(got-want) > 1e-14*max(want, 1) {
+		t.Errorf("%s: got %v, want %v", name, got, want)
+	}
+}
+
+func TestLange(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{{0, 0}, {0, 3}, {3, 0}, {1, 1}, {4, 7}, {7, 4}, {20, 20}} {
+		m, n := dims[0], dims[1]
+		lda := max(1, n) + 2
+		a := randomGeneral(m, n, lda, rnd)
+		for _, norm := range matrixNorms {
+			got := Lange(norm, m, n, a, lda, make([]float64, n))
+			checkNorm(t, fmt.Sprintf("m=%d,n=%d,norm=%c", m, n, norm), got, naiveNorm(norm, m, n, a, lda))
+		}
+	}
+}
+
+func TestLansy(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 5, 20} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			lda := max(1, n) + 2
+			// Only the uplo triangle of a is referenced, so the other one
+			// is left unrelated to it.
+			a := randomGeneral(n, n, lda, rnd)
+			s := symmetric(uplo, n, a, lda)
+			for _, norm := range matrixNorms {
+				got := Lansy(norm, uplo, n, a, lda, make([]float64, n))
+				checkNorm(t, fmt.Sprintf("n=%d,uplo=%c,norm=%c", n, uplo, norm), got, naiveNorm(norm, n, n, s, max(1, n)))
+			}
+		}
+	}
+}
+
+func TestLantr(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{{0, 0}, {1, 1}, {4, 7}, {7, 4}, {20, 20}} {
+		m, n := dims[0], dims[1]
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, diag := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				lda := max(1, n) + 1
+				a := randomGeneral(m, n, lda, rnd)
+				// The trapezoid as a dense matrix.
+				tr := make([]float64, m*n)
+				for i := 0; i < m; i++ {
+					for j := 0; j < n; j++ {
+						switch {
+						case i == j && diag == blas.Unit:
+							tr[i*n+j] = 1
+						case (uplo == blas.Upper && j >= i) || (uplo == blas.Lower && j <= i):
+							tr[i*n+j] = a[i*lda+j]
+						}
+					}
+				}
+				for _, norm := range matrixNorms {
+					got := Lantr(norm, uplo, diag, m, n, a, lda, make([]float64, n))
+					name := fmt.Sprintf("m=%d,n=%d,uplo=%c,diag=%c,norm=%c", m, n, uplo, diag, norm)
+					checkNorm(t, name, got, naiveNorm(norm, m, n, tr, max(1, n)))
+				}
+			}
+		}
+	}
+}
+
+func TestLanst(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 5, 20} {
+		d := make([]float64, n)
+		e := make([]float64, max(0, n-1))
+		a := make([]float64, n*n)
+		for i := range d {
+			d[i] = 2*rnd.Float64() - 1
+			a[i*n+i] = d[i]
+		}
+		for i := range e {
+			e[i] = 2*rnd.Float64() - 1
+			a[i*n+i+1], a[(i+1)*n+i] = e[i], e[i]
+		}
+		for _, norm := range matrixNorms {
+			checkNorm(t, fmt.Sprintf("n=%d,norm=%c", n, norm), Lanst(norm, n, d, e), naiveNorm(norm, n, n, a, max(1, n)))
+		}
+	}
+}
+
+func TestLassq(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, test := range []struct {
+		name string
+		size float64
+	}{
+		{"unit", 1},
+		{"overflow", 1e300},
+		{"underflow", 1e-300},
+	} {
+		for _, inc := range []int{1, 3} {
+			const n = 50
+			x := make([]float64, 1+(n-1)*inc)
+			var want float64
+			for i := 0; i < n; i++ {
+				v := 2*rnd.Float64() - 1
+				x[i*inc] = test.size * v
+				want += v * v
+			}
+			// Start from a nonzero sum so that it is accumulated too.
+			want = test.size * math.Sqrt(want+4)
+			scl, smsq := Lassq(n, x, inc, 2*test.size, 1)
+			name := fmt.Sprintf("%s,inc=%d", test.name, inc)
+			if got := scl * math.Sqrt(smsq); math.Abs(got-want) > 1e-14*want {
+				t.Errorf("%s: got %v, want %v", name, got, want)
+			}
+		}
+	}
+
+	// Lange computes the Frobenius norm with Lassq, so it must not overflow
+	// where the sum of squares does.
+	const big = 1e300
+	a := []float64{big, -big, big, big}
+	want := 2 * big
+	if got := Lange(lapack.Frobenius, 2, 2, a, 2, nil); math.Abs(got-want) > 1e-14*want {
+		t.Errorf("Frobenius overflow: got %v, want %v", got, want)
+	}
+}
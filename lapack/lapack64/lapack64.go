@@ -0,0 +1,55 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack64
+
+const (
+	// lamchE is the machine epsilon. For IEEE this is 2^{-53}.
+	lamchE = 0x1p-53
+
+	// lamchB is the radix of the machine (the base of the number system).
+	lamchB = 2
+
+	// lamchP is base * eps.
+	lamchP = lamchB * lamchE
+
+	// lamchS is the "safe minimum", that is, the lowest number such that
+	// 1/lamchS does not overflow, or also the smallest normal number.
+	// For IEEE this is 2^{-1022}.
+	lamchS = 0x1p-1022
+
+	// Blue's scaling constants
+	//
+	// An n-vector x is well-scaled if
+	//  tsml ≤ |xᵢ| ≤ tbig for 0 ≤ i < n and n ≤ 1/lamchP,
+	// where
+	//  tsml = 2^ceil((expmin-1)/2) = 2^ceil((-1021-1)/2) = 2^{-511} = 1.4916681462400413e-154
+	//  tbig = 2^floor((expmax-digits+1)/2) = 2^floor((1024-53+1)/2) = 2^{486} = 1.997919072202235e+146
+	// If any xᵢ is not well-scaled, then multiplying small values by ssml and
+	// large values by sbig avoids underflow or overflow when computing the sum
+	// of squares \sum_0^{n-1} (xᵢ)².
+	//  ssml = 2^{-floor((expmin-digits)/2)} = 2^{-floor((-1021-53)/2)} = 2^537 = 4.4989137945431964e+161
+	//  sbig = 2^{-ceil((expmax+digits-1)/2)} = 2^{-ceil((1024+53-1)/2)} = 2^{-538} = 1.1113793747425387e-162
+	//
+	// References:
+	//  - Anderson E. (2017)
+	//    Algorithm 978: Safe Scaling in the Level 1 BLAS
+	//    ACM Trans Math Softw 44:1--28
+	//    https://doi.org/10.1145/3061665
+	//  - Blue, James L. (1978)
+	//    A Portable Fortran Program to Find the Euclidean Norm of a Vector
+	//    ACM Trans Math Softw 4:15--23
+	//    https://doi.org/10.1145/355769.355771
+	tsml = 0x1p-511
+	tbig = 0x1p486
+	ssml = 0x1p537
+	sbig = 0x1p-538
+)
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
@@ -0,0 +1,14 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack64
+
+import "math"
+
+// Lapy2 is the LAPACK version of math.Hypot.
+//
+// Lapy2 is an internal routine.
+func Lapy2(x, y float64) float64 {
+	return math.Hypot(x, y)
+}
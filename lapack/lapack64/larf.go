@@ -0,0 +1,102 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack64
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/blas64"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Larf applies an elementary reflector H to an m×n matrix C:
+//
+//	C = H * C  if side == blas.Left
+//	C = C * H  if side == blas.Right
+//
+// H is represented in the form
+//
+//	H = I - tau * v * vᵀ
+//
+// where tau is a scalar and v is a vector.
+//
+// work must have length at least m if side == blas.Left and
+// at least n if side == blas.Right.
+//
+// Larf is an internal routine.
+func Larf(side blas.Side, m, n int, v []float64, incv int, tau float64, c []float64, ldc int, work []float64) {
+	switch {
+	case side != blas.Left && side != blas.Right:
+		panic(lapack.ErrBadSide)
+	case m < 0:
+		panic(lapack.ErrMLT0)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case incv == 0:
+		panic(lapack.ErrZeroIncV)
+	case ldc < max(1, n):
+		panic(lapack.ErrBadLdC)
+	}
+
+	if m == 0 || n == 0 {
+		return
+	}
+
+	applyleft := side == blas.Left
+	lenV := n
+	if applyleft {
+		lenV = m
+	}
+
+	switch {
+	case len(v) < 1+(lenV-1)*abs(incv):
+		panic(lapack.ErrShortV)
+	case len(c) < (m-1)*ldc+n:
+		panic(lapack.ErrShortC)
+	case (applyleft && len(work) < n) || (!applyleft && len(work) < m):
+		panic(lapack.ErrShortWork)
+	}
+
+	lastv := -1 // last non-zero element of v
+	lastc := -1 // last non-zero row/column of C
+	if tau != 0 {
+		if applyleft {
+			lastv = m - 1
+		} else {
+			lastv = n - 1
+		}
+		var i int
+		if incv > 0 {
+			i = lastv * incv
+		}
+		// Look for the last non-zero row in v.
+		for lastv >= 0 && v[i] == 0 {
+			lastv--
+			i -= incv
+		}
+		if applyleft {
+			// Scan for the last non-zero column in C[0:lastv, :]
+			lastc = Ilalc(lastv+1, n, c, ldc)
+		} else {
+			// Scan for the last non-zero row in C[:, 0:lastv]
+			lastc = Ilalr(m, lastv+1, c, ldc)
+		}
+	}
+	if lastv == -1 || lastc == -1 {
+		return
+	}
+	if applyleft {
+		// Form H * C
+		// w[0:lastc+1] = c[1:lastv+1, 1:lastc+1]ᵀ * v[1:lastv+1,1]
+		blas64.Gemv(blas.Trans, lastv+1, lastc+1, 1, c, ldc, v, incv, 0, work, 1)
+		// c[0: lastv, 0: lastc] = c[...] - w[0:lastv, 1] * v[1:lastc, 1]ᵀ
+		blas64.Ger(lastv+1, lastc+1, -tau, v, incv, work, 1, c, ldc)
+	} else {
+		// Form C * H
+		// w[0:lastc+1,1] := c[0:lastc+1,0:lastv+1] * v[0:lastv+1,1]
+		blas64.Gemv(blas.NoTrans, lastc+1, lastv+1, 1, c, ldc, v, incv, 0, work, 1)
+		// c[0:lastc+1,0:lastv+1] = c[...] - w[0:lastc+1,0] * v[0:lastv+1,0]ᵀ
+		blas64.Ger(lastc+1, lastv+1, -tau, work, 1, v, incv, c, ldc)
+	}
+}
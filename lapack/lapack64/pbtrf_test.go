@@ -0,0 +1,158 @@
+package lapack64
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+// bandSizes are the n and kd of the band Cholesky tests. The large ones use
+// the blocked code.
+var bandSizes = [][2]int{
+	{0, 0}, {1, 0}, {5, 0}, {5, 1}, {5, 4}, {10, 3},
+	{100, 10}, {100, 40}, {150, 64}, {50, 60},
+}
+
+// randomSPDBand returns a random n×n symmetric positive definite band matrix
+// with kd sub- and super-diagonals, with row stride n.
+func randomSPDBand(n, kd int, rnd *rand.Rand) []float64 {
+	a := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j <= min(i+kd, n-1); j++ {
+			a[i*n+j] = 2*rnd.Float64() - 1
+			a[j*n+i] = a[i*n+j]
+		}
+		// Diagonal dominance makes A positive definite.
+		a[i*n+i] = float64(2*kd+1) * (1 + rnd.Float64())
+	}
+	return a
+}
+
+// toBand returns the uplo triangle of the dense n×n band matrix a with row
+// stride n in band storage with row stride ldab. The unused elements of the
+// band storage are set to NaN.
+func toBand(uplo blas.Uplo, n, kd int, a []float64, ldab int) []float64 {
+	ab := make([]float64, max(0, (n-1)*ldab+kd+1))
+	for i := range ab {
+		ab[i] = math.NaN()
+	}
+	for i := 0; i < n; i++ {
+		for j := max(0, i-kd); j <= min(i+kd, n-1); j++ {
+			switch {
+			case uplo == blas.Upper && j >= i:
+				ab[i*ldab+j-i] = a[i*n+j]
+			case uplo == blas.Lower && j <= i:
+				ab[i*ldab+kd+j-i] = a[i*n+j]
+			}
+		}
+	}
+	return ab
+}
+
+// fromBand returns the n×n triangular band matrix stored in ab with row
+// stride ldab as a dense matrix with row stride n.
+func fromBand(uplo blas.Uplo, n, kd int, ab []float64, ldab int) []float64 {
+	a := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		if uplo == blas.Upper {
+			for j := i; j <= min(i+kd, n-1); j++ {
+				a[i*n+j] = ab[i*ldab+j-i]
+			}
+		} else {
+			for j := max(0, i-kd); j <= i; j++ {
+				a[i*n+j] = ab[i*ldab+kd+j-i]
+			}
+		}
+	}
+	return a
+}
+
+func TestPbtrf(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range bandSizes {
+		n, kd := dims[0], dims[1]
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			a := randomSPDBand(n, kd, rnd)
+			ldab := kd + 3
+			ab := toBand(uplo, n, kd, a, ldab)
+			name := fmt.Sprintf("n=%d,kd=%d,uplo=%c", n, kd, uplo)
+			if !Pbtrf(uplo, n, kd, ab, ldab) {
+				t.Errorf("%s: unexpected failure", name)
+				continue
+			}
+			f := fromBand(uplo, n, kd, ab, ldab)
+			var llt []float64
+			if uplo == blas.Upper {
+				llt = mul(blas.Trans, blas.NoTrans, n, n, n, f, max(1, n), f, max(1, n))
+			} else {
+				llt = mul(blas.NoTrans, blas.Trans, n, n, n, f, max(1, n), f, max(1, n))
+			}
+			checkResidual(t, name+": A != Uᵀ*U or L*Lᵀ", n, n, a, max(1, n), llt, max(1, n), n, 10)
+		}
+	}
+}
+
+func TestPbtrfNotPD(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{{1, 0}, {5, 2}, {100, 40}} {
+		n, kd := dims[0], dims[1]
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			a := randomSPDBand(n, kd, rnd)
+			a[(n-1)*n+n-1] = -1
+			ab := toBand(uplo, n, kd, a, kd+1)
+			if Pbtrf(uplo, n, kd, ab, kd+1) {
+				t.Errorf("n=%d,kd=%d,uplo=%c: indefinite matrix not detected", n, kd, uplo)
+			}
+		}
+	}
+}
+
+func TestPbtrs(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range bandSizes {
+		n, kd := dims[0], dims[1]
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, nrhs := range []int{1, 3} {
+				a := randomSPDBand(n, kd, rnd)
+				ldab, ldb := kd+1, nrhs+2
+				ab := toBand(uplo, n, kd, a, ldab)
+				b := randomGeneral(n, nrhs, ldb, rnd)
+				bCopy := cloneGeneral(n, nrhs, b, ldb)
+				name := fmt.Sprintf("n=%d,kd=%d,uplo=%c,nrhs=%d", n, kd, uplo, nrhs)
+				if !Pbtrf(uplo, n, kd, ab, ldab) {
+					t.Fatalf("%s: unexpected failure", name)
+				}
+				Pbtrs(uplo, n, kd, nrhs, ab, ldab, b, ldb)
+				ax := mul(blas.NoTrans, blas.NoTrans, n, nrhs, n, a, max(1, n), b, ldb)
+				checkResidual(t, name+": A*X != B", n, nrhs, bCopy, nrhs, ax, nrhs, n, 100)
+			}
+		}
+	}
+}
+
+func TestPbcon(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range bandSizes[1:] {
+		n, kd := dims[0], dims[1]
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			a := randomSPDBand(n, kd, rnd)
+			// Grade the diagonal to make the matrix less well conditioned.
+			for i := 0; i < n; i++ {
+				a[i*n+i] *= float64(i + 1)
+			}
+			anorm := oneNorm(n, n, a, n)
+			rcond := 1 / (anorm * oneNorm(n, n, inverse(n, a, n), n))
+			ldab := kd + 1
+			ab := toBand(uplo, n, kd, a, ldab)
+			name := fmt.Sprintf("n=%d,kd=%d,uplo=%c", n, kd, uplo)
+			if !Pbtrf(uplo, n, kd, ab, ldab) {
+				t.Fatalf("%s: unexpected failure", name)
+			}
+			got := Pbcon(uplo, n, kd, ab, ldab, anorm, make([]float64, 3*n), make([]int, n))
+			checkRcond(t, name, got, rcond)
+		}
+	}
+}
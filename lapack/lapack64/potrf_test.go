@@ -0,0 +1,113 @@
+package lapack64
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+// choleskyProduct returns Uᵀ*U or L*Lᵀ for the Cholesky factor stored in the
+// uplo triangle of a, with row stride n.
+func choleskyProduct(uplo blas.Uplo, n int, a []float64, lda int) []float64 {
+	f := triangle(uplo, false, n, a, lda)
+	if uplo == blas.Upper {
+		return mul(blas.Trans, blas.NoTrans, n, n, n, f, max(1, n), f, max(1, n))
+	}
+	return mul(blas.NoTrans, blas.Trans, n, n, n, f, max(1, n), f, max(1, n))
+}
+
+var choleskySizes = []int{0, 1, 2, 5, 10, 63, 64, 65, 150}
+
+func TestPotrf(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range choleskySizes {
+			lda := max(1, n) + 3
+			a := randomSPD(n, lda, rnd)
+			aCopy := cloneGeneral(n, n, a, lda)
+			name := fmt.Sprintf("uplo=%c,n=%d", uplo, n)
+			if !Potrf(uplo, n, a, lda) {
+				t.Errorf("%s: unexpected failure", name)
+				continue
+			}
+			checkResidual(t, name+": A != factor product", n, n, aCopy, max(1, n), choleskyProduct(uplo, n, a, lda), max(1, n), n, 10)
+		}
+	}
+}
+
+func TestPotrfNotPD(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{1, 5, 150} {
+			a := randomSPD(n, n, rnd)
+			a[(n/2)*n+n/2] = -1
+			if Potrf(uplo, n, a, n) {
+				t.Errorf("uplo=%c,n=%d: matrix that is not positive definite not detected", uplo, n)
+			}
+		}
+	}
+}
+
+func TestPotrs(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range choleskySizes {
+			for _, nrhs := range []int{1, 4} {
+				lda, ldb := max(1, n)+1, nrhs+2
+				a := randomSPD(n, lda, rnd)
+				aCopy := cloneGeneral(n, n, a, lda)
+				b := randomGeneral(n, nrhs, ldb, rnd)
+				bCopy := cloneGeneral(n, nrhs, b, ldb)
+				if !Potrf(uplo, n, a, lda) {
+					t.Fatalf("uplo=%c,n=%d: unexpected failure", uplo, n)
+				}
+				Potrs(uplo, n, nrhs, a, lda, b, ldb)
+				ax := mul(blas.NoTrans, blas.NoTrans, n, nrhs, n, aCopy, max(1, n), b, ldb)
+				name := fmt.Sprintf("uplo=%c,n=%d,nrhs=%d", uplo, n, nrhs)
+				checkResidual(t, name+": A*X != B", n, nrhs, bCopy, nrhs, ax, nrhs, n, 100)
+			}
+		}
+	}
+}
+
+func TestPotri(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range choleskySizes {
+			lda := max(1, n) + 2
+			a := randomSPD(n, lda, rnd)
+			aCopy := cloneGeneral(n, n, a, lda)
+			name := fmt.Sprintf("uplo=%c,n=%d", uplo, n)
+			if !Potrf(uplo, n, a, lda) || !Potri(uplo, n, a, lda) {
+				t.Errorf("%s: unexpected failure", name)
+				continue
+			}
+			ainv := symmetric(uplo, n, a, lda)
+			prod := mul(blas.NoTrans, blas.NoTrans, n, n, n, aCopy, max(1, n), ainv, max(1, n))
+			checkResidual(t, name+": A*inv(A) != I", n, n, eye(n, max(1, n)), max(1, n), prod, max(1, n), n, 100)
+		}
+	}
+}
+
+func TestPocon(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range choleskySizes[1:] {
+			lda := n + 2
+			a := randomSPD(n, lda, rnd)
+			// Grade the diagonal to make the matrix less well conditioned.
+			for i := 0; i < n; i++ {
+				a[i*lda+i] *= float64(i + 1)
+			}
+			anorm := oneNorm(n, n, a, lda)
+			rcond := 1 / (anorm * oneNorm(n, n, inverse(n, a, lda), n))
+			if !Potrf(uplo, n, a, lda) {
+				t.Fatalf("uplo=%c,n=%d: unexpected failure", uplo, n)
+			}
+			got := Pocon(uplo, n, a, lda, anorm, make([]float64, 3*n), make([]int, n))
+			checkRcond(t, fmt.Sprintf("uplo=%c,n=%d", uplo, n), got, rcond)
+		}
+	}
+}
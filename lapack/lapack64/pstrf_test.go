@@ -0,0 +1,74 @@
+package lapack64
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/blas64"
+)
+
+// randomPSD returns a random n×n symmetric positive semidefinite matrix of
+// the given rank, stored with row stride lda.
+func randomPSD(n, rank, lda int, rnd *rand.Rand) []float64 {
+	b := randomGeneral(n, rank, max(1, rank), rnd)
+	a := randomGeneral(n, n, lda, rnd)
+	if n > 0 {
+		blas64.Gemm(blas.NoTrans, blas.Trans, n, n, rank, 1, b, max(1, rank), b, max(1, rank), 0, a, lda)
+	}
+	return a
+}
+
+func TestPstrf(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 5, 10, 100, 150} {
+		for _, rank := range []int{n, n / 2, 1} {
+			if rank > n {
+				continue
+			}
+			for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+				lda := max(1, n) + 2
+				a := randomPSD(n, rank, lda, rnd)
+				aCopy := cloneGeneral(n, n, a, lda)
+				piv := make([]int, n)
+				got, ok := Pstrf(uplo, n, a, lda, piv, -1, make([]float64, 2*n))
+				name := fmt.Sprintf("n=%d,rank=%d,uplo=%c", n, rank, uplo)
+				if got != rank {
+					t.Errorf("%s: got rank %d", name, got)
+					continue
+				}
+				if ok != (rank == n) {
+					t.Errorf("%s: got ok=%t", name, ok)
+				}
+				if !isPermutation(piv) {
+					t.Errorf("%s: piv %v is not a permutation", name, piv)
+					continue
+				}
+
+				// Only the leading rank rows of U or columns of L
+				// are part of the factorization.
+				f := triangle(uplo, false, n, a, lda)
+				for i := 0; i < n; i++ {
+					for j := rank; j < n; j++ {
+						if uplo == blas.Upper {
+							f[j*n+i] = 0
+						} else {
+							f[i*n+j] = 0
+						}
+					}
+				}
+				var llt []float64
+				if uplo == blas.Upper {
+					llt = mul(blas.Trans, blas.NoTrans, n, n, n, f, max(1, n), f, max(1, n))
+				} else {
+					llt = mul(blas.NoTrans, blas.Trans, n, n, n, f, max(1, n), f, max(1, n))
+				}
+				// Form Pᵀ*A*P.
+				Lapmt(true, n, n, aCopy, max(1, n), piv)
+				Lapmr(true, n, n, aCopy, max(1, n), piv)
+				checkResidual(t, name+": Pᵀ*A*P != Uᵀ*U or L*Lᵀ", n, n, aCopy, max(1, n), llt, max(1, n), n, 10)
+			}
+		}
+	}
+}
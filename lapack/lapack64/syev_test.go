@@ -0,0 +1,56 @@
+package lapack64
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/lapack"
+)
+
+func TestSyev(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 5, 10, 50, 100} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			lda := max(1, n) + 3
+			a := randomSymmetric(n, lda, rnd)
+			aSym := cloneGeneral(n, n, a, lda)
+			w := make([]float64, n)
+			work := optimalWork(func(work []float64, lwork int) {
+				Syev(lapack.EVCompute, uplo, n, a, lda, w, work, lwork)
+			})
+			name := fmt.Sprintf("n=%d,uplo=%c", n, uplo)
+
+			// The eigenvalues alone must match those computed with the
+			// eigenvectors.
+			wNone := make([]float64, n)
+			if !Syev(lapack.EVNone, uplo, n, cloneGeneral(n, n, a, lda), max(1, n), wNone, work, len(work)) {
+				t.Errorf("%s: no convergence without eigenvectors", name)
+				continue
+			}
+			if !Syev(lapack.EVCompute, uplo, n, a, lda, w, work, len(work)) {
+				t.Errorf("%s: no convergence", name)
+				continue
+			}
+			for i := 1; i < n; i++ {
+				if w[i] < w[i-1] {
+					t.Errorf("%s: eigenvalues %v not ascending", name, w)
+					break
+				}
+			}
+			checkResidual(t, name+": eigenvalues differ with jobz", 1, n, w, n, wNone, n, n, 10)
+
+			// The columns of V are the eigenvectors, so A*V = V*Λ.
+			checkOrthogonal(t, name+": V", n, n, a, lda, false)
+			av := mul(blas.NoTrans, blas.NoTrans, n, n, n, aSym, max(1, n), a, lda)
+			vl := cloneGeneral(n, n, a, lda)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					vl[i*n+j] *= w[j]
+				}
+			}
+			checkResidual(t, name+": A*V != V*Λ", n, n, av, max(1, n), vl, max(1, n), n, 10)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack64
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/blas64"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Trti2 computes the inverse of a triangular matrix, storing the result in place
+// into a. This is the BLAS level 2 version of the algorithm.
+//
+// Trti2 is an internal routine.
+func Trti2(uplo blas.Uplo, diag blas.Diag, n int, a []float64, lda int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(lapack.ErrBadUplo)
+	case diag != blas.NonUnit && diag != blas.Unit:
+		panic(lapack.ErrBadDiag)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	}
+
+	if n == 0 {
+		return
+	}
+
+	if len(a) < (n-1)*lda+n {
+		panic(lapack.ErrShortA)
+	}
+
+	nonUnit := diag == blas.NonUnit
+	if uplo == blas.Upper {
+		for j := 0; j < n; j++ {
+			var ajj float64
+			if nonUnit {
+				ajj = 1 / a[j*lda+j]
+				a[j*lda+j] = ajj
+				ajj *= -1
+			} else {
+				ajj = -1
+			}
+			blas64.Trmv(blas.Upper, blas.NoTrans, diag, j, a, lda, a[j:], lda)
+			blas64.Scal(j, ajj, a[j:], lda)
+		}
+		return
+	}
+	for j := n - 1; j >= 0; j-- {
+		var ajj float64
+		if nonUnit {
+			ajj = 1 / a[j*lda+j]
+			a[j*lda+j] = ajj
+			ajj *= -1
+		} else {
+			ajj = -1
+		}
+		if j < n-1 {
+			blas64.Trmv(blas.Lower, blas.NoTrans, diag, n-j-1, a[(j+1)*lda+j+1:], lda, a[(j+1)*lda+j:], lda)
+			blas64.Scal(n-j-1, ajj, a[(j+1)*lda+j:], lda)
+		}
+	}
+}
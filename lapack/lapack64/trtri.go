@@ -0,0 +1,71 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapack64
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/blas64"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Trtri computes the inverse of a triangular matrix, storing the result in place
+// into a. This is the BLAS level 3 version of the algorithm which builds upon
+// Trti2 to operate on matrix blocks instead of only individual columns.
+//
+// Trtri will not perform the inversion if the matrix is singular, and returns
+// a boolean indicating whether the inversion was successful.
+func Trtri(uplo blas.Uplo, diag blas.Diag, n int, a []float64, lda int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(lapack.ErrBadUplo)
+	case diag != blas.NonUnit && diag != blas.Unit:
+		panic(lapack.ErrBadDiag)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	}
+
+	if n == 0 {
+		return true
+	}
+
+	if len(a) < (n-1)*lda+n {
+		panic(lapack.ErrShortA)
+	}
+
+	if diag == blas.NonUnit {
+		for i := 0; i < n; i++ {
+			if a[i*lda+i] == 0 {
+				return false
+			}
+		}
+	}
+
+	nb := Ilaenv(1, "DTRTRI", "UD", n, -1, -1, -1)
+	if nb <= 1 || nb > n {
+		Trti2(uplo, diag, n, a, lda)
+		return true
+	}
+	if uplo == blas.Upper {
+		for j := 0; j < n; j += nb {
+			jb := min(nb, n-j)
+			blas64.Trmm(blas.Left, blas.Upper, blas.NoTrans, diag, j, jb, 1, a, lda, a[j:], lda)
+			blas64.Trsm(blas.Right, blas.Upper, blas.NoTrans, diag, j, jb, -1, a[j*lda+j:], lda, a[j:], lda)
+			Trti2(blas.Upper, diag, jb, a[j*lda+j:], lda)
+		}
+		return true
+	}
+	nn := ((n - 1) / nb) * nb
+	for j := nn; j >= 0; j -= nb {
+		jb := min(nb, n-j)
+		if j+jb <= n-1 {
+			blas64.Trmm(blas.Left, blas.Lower, blas.NoTrans, diag, n-j-jb, jb, 1, a[(j+jb)*lda+j+jb:], lda, a[(j+jb)*lda+j:], lda)
+			blas64.Trsm(blas.Right, blas.Lower, blas.NoTrans, diag, n-j-jb, jb, -1, a[j*lda+j:], lda, a[(j+jb)*lda+j:], lda)
+		}
+		Trti2(blas.Lower, diag, jb, a[j*lda+j:], lda)
+	}
+	return true
+}
@@ -0,0 +1,134 @@
+package lapack64
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+)
+
+// randomTriangular returns a random n×n matrix with row stride lda whose
+// triangles are well conditioned. The off-diagonal elements are shrunk so that
+// the condition number does not grow exponentially with n.
+func randomTriangular(n, lda int, rnd *rand.Rand) []float64 {
+	a := randomGeneral(n, n, lda, rnd)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i != j {
+				a[i*lda+j] /= float64(n)
+			}
+		}
+		a[i*lda+i] += 2
+	}
+	return a
+}
+
+func TestTrtri(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 5, 10, 63, 64, 65, 150} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, diag := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				lda := max(1, n) + 2
+				a := randomTriangular(n, lda, rnd)
+				tri := triangle(uplo, diag == blas.Unit, n, a, lda)
+				name := fmt.Sprintf("n=%d,uplo=%c,diag=%c", n, uplo, diag)
+				if !Trtri(uplo, diag, n, a, lda) {
+					t.Errorf("%s: unexpected singular matrix", name)
+					continue
+				}
+				inv := triangle(uplo, diag == blas.Unit, n, a, lda)
+				ainv := mul(blas.NoTrans, blas.NoTrans, n, n, n, tri, max(1, n), inv, max(1, n))
+				checkResidual(t, name+": A*inv(A) != I", n, n, eye(n, max(1, n)), max(1, n), ainv, max(1, n), n, 10)
+			}
+		}
+	}
+}
+
+func TestTrtriSingular(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 5, 150} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			a := randomTriangular(n, n, rnd)
+			a[n/2*n+n/2] = 0
+			name := fmt.Sprintf("n=%d,uplo=%c", n, uplo)
+			if Trtri(uplo, blas.NonUnit, n, a, n) {
+				t.Errorf("%s: singular matrix not detected", name)
+			}
+			// A unit triangular matrix is never singular.
+			if !Trtri(uplo, blas.Unit, n, a, n) {
+				t.Errorf("%s: unit diagonal reported singular", name)
+			}
+		}
+	}
+}
+
+func TestTbtrs(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range bandSizes {
+		n, kd := dims[0], dims[1]
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				for _, diag := range []blas.Diag{blas.NonUnit, blas.Unit} {
+					const nrhs = 3
+					ldab, ldb := kd+2, nrhs+1
+					// A triangular band matrix is the triangle of
+					// a symmetric band matrix. Its off-diagonal
+					// elements are shrunk so that the unit
+					// triangular matrix is well conditioned too.
+					a := randomSPDBand(n, kd, rnd)
+					for i := range a {
+						if i%(n+1) != 0 {
+							a[i] /= float64(kd + 1)
+						}
+					}
+					ab := toBand(uplo, n, kd, a, ldab)
+					tri := fromBand(uplo, n, kd, ab, ldab)
+					if diag == blas.Unit {
+						for i := 0; i < n; i++ {
+							tri[i*n+i] = 1
+						}
+					}
+					b := randomGeneral(n, nrhs, ldb, rnd)
+					bCopy := cloneGeneral(n, nrhs, b, ldb)
+					name := fmt.Sprintf("n=%d,kd=%d,uplo=%c,trans=%c,diag=%c", n, kd, uplo, trans, diag)
+					if !Tbtrs(uplo, trans, diag, n, kd, nrhs, ab, ldab, b, ldb) {
+						t.Errorf("%s: unexpected singular matrix", name)
+						continue
+					}
+					ax := mul(trans, blas.NoTrans, n, nrhs, n, tri, max(1, n), b, ldb)
+					checkResidual(t, name+": op(A)*X != B", n, nrhs, bCopy, nrhs, ax, nrhs, n, 100)
+				}
+			}
+		}
+	}
+}
+
+func TestTbtrsSingular(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{{1, 0}, {5, 2}, {100, 40}} {
+		n, kd := dims[0], dims[1]
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, diag := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				const nrhs = 2
+				a := randomSPDBand(n, kd, rnd)
+				a[n/2*n+n/2] = 0
+				ab := toBand(uplo, n, kd, a, kd+1)
+				tri := fromBand(uplo, n, kd, ab, kd+1)
+				b := randomGeneral(n, nrhs, nrhs, rnd)
+
+				// Tbtrs must report singularity exactly as Trtrs does for
+				// the same matrix in full storage.
+				want := Trtrs(uplo, blas.NoTrans, diag, n, nrhs, tri, max(1, n), cloneGeneral(n, nrhs, b, nrhs), nrhs)
+				got := Tbtrs(uplo, blas.NoTrans, diag, n, kd, nrhs, ab, kd+1, b, nrhs)
+				name := fmt.Sprintf("n=%d,kd=%d,uplo=%c,diag=%c", n, kd, uplo, diag)
+				if got != want {
+					t.Errorf("%s: Tbtrs returned %t, Trtrs returned %t", name, got, want)
+				}
+				if got != (diag == blas.Unit) {
+					t.Errorf("%s: got ok=%t", name, got)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapackc128
+
+import (
+	"math"
+
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/lapack"
+	"github.com/gocnn/gomat/lapack/lapack64"
+)
+
+// Heev computes all eigenvalues and, optionally, the eigenvectors of an n×n
+// Hermitian matrix A.
+//
+// w contains the eigenvalues in ascending order upon return. w must have length
+// at least n, and Heev will panic otherwise.
+//
+// On entry, a contains the elements of the Hermitian matrix A in the triangular
+// portion specified by uplo. If jobz == lapack.EVCompute, a contains the
+// orthonormal eigenvectors of A on exit, otherwise jobz must be lapack.EVNone
+// and on exit the specified triangular region is overwritten.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At minimum,
+// lwork >= 2*n-1, and Heev will panic otherwise. The amount of blocking is
+// limited by the usable length. If lwork == -1, instead of computing Heev the
+// optimal work length is stored into work[0].
+//
+// rwork is real temporary storage and must have length at least max(1, 3*n-2),
+// otherwise Heev will panic. rwork is not referenced if lwork == -1.
+//
+// Heev returns whether the eigenvalue computation converged.
+func Heev(jobz lapack.EVJob, uplo blas.Uplo, n int, a []complex128, lda int, w []float64, work []complex128, lwork int, rwork []float64) (ok bool) {
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(lapack.ErrBadEVJob)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(lapack.ErrBadUplo)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	case lwork < max(1, 2*n-1) && lwork != -1:
+		panic(lapack.ErrBadLWork)
+	case len(work) < max(1, lwork):
+		panic(lapack.ErrShortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	nb := lapack64.Ilaenv(1, "ZHETRD", string(uplo), n, -1, -1, -1)
+	lworkopt := max(1, (nb+1)*n)
+	if lwork == -1 {
+		work[0] = complex(float64(lworkopt), 0)
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(lapack.ErrShortA)
+	case len(w) < n:
+		panic(lapack.ErrShortW)
+	case len(rwork) < max(1, 3*n-2):
+		panic(lapack.ErrShortWork)
+	}
+
+	if n == 1 {
+		w[0] = real(a[0])
+		work[0] = 1
+		if jobz == lapack.EVCompute {
+			a[0] = 1
+		}
+		return true
+	}
+
+	safmin := lamchS
+	eps := lamchP
+	smlnum := safmin / eps
+	bignum := 1 / smlnum
+	rmin := math.Sqrt(smlnum)
+	rmax := math.Sqrt(bignum)
+
+	// Scale matrix to allowable range, if necessary.
+	anrm := Lanhe(lapack.MaxAbs, uplo, n, a, lda, rwork)
+	scaled := false
+	var sigma float64
+	if anrm > 0 && anrm < rmin {
+		scaled = true
+		sigma = rmin / anrm
+	} else if anrm > rmax {
+		scaled = true
+		sigma = rmax / anrm
+	}
+	if scaled {
+		kind := lapack.LowerTri
+		if uplo == blas.Upper {
+			kind = lapack.UpperTri
+		}
+		Lascl(kind, 0, 0, 1, sigma, n, n, a, lda)
+	}
+	// The off-diagonal elements of the tridiagonal matrix are stored at the
+	// start of rwork, followed by the workspace of Steqr. The scalar factors
+	// of the elementary reflectors are stored at the start of work.
+	var inde int
+	indrwork := inde + n
+	var indtau int
+	indwork := indtau + n
+	llwork := lwork - indwork
+	Hetrd(uplo, n, a, lda, w, rwork[inde:], work[indtau:indtau+n-1], work[indwork:], llwork)
+
+	// For eigenvalues only, call Sterf. For eigenvectors, first call Ungtr
+	// to generate the unitary matrix, then call Steqr.
+	if jobz == lapack.EVNone {
+		ok = lapack64.Sterf(n, w, rwork[inde:])
+	} else {
+		Ungtr(uplo, n, a, lda, work[indtau:indtau+n-1], work[indwork:], llwork)
+		ok = Steqr(lapack.EVComp(jobz), n, w, rwork[inde:], a, lda, rwork[indrwork:])
+	}
+	if !ok {
+		return false
+	}
+
+	// If the matrix was scaled, then rescale eigenvalues appropriately.
+	if scaled {
+		for i := range w[:n] {
+			w[i] /= sigma
+		}
+	}
+	work[0] = complex(float64(lworkopt), 0)
+	return true
+}
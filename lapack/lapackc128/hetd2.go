@@ -0,0 +1,152 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapackc128
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/c128"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Hetd2 reduces a Hermitian n×n matrix A to real symmetric tridiagonal form T
+// by a unitary similarity transformation
+//
+//	Qᴴ * A * Q = T
+//
+// On entry, the matrix is contained in the specified triangle of a. On exit,
+// if uplo == blas.Upper, the diagonal and first super-diagonal of a are
+// overwritten with the elements of T. The elements above the first super-diagonal
+// are overwritten with the elementary reflectors that are used with
+// the elements written to tau in order to construct Q. If uplo == blas.Lower,
+// the elements are written in the lower triangular region.
+//
+// d must have length at least n. e and tau must have length at least n-1. Hetd2
+// will panic if these sizes are not met.
+//
+// Q is represented as a product of elementary reflectors.
+// If uplo == blas.Upper
+//
+//	Q = H_{n-2} * ... * H_1 * H_0
+//
+// and if uplo == blas.Lower
+//
+//	Q = H_0 * H_1 * ... * H_{n-2}
+//
+// where
+//
+//	H_i = I - tau * v * vᴴ
+//
+// where tau is stored in tau[i], and v is stored in a.
+//
+// If uplo == blas.Upper, v[0:i-1] is stored in A[0:i-1,i+1], v[i] = 1, and
+// v[i+1:] = 0. The elements of a are
+//
+//	[ d   e  v2  v3  v4]
+//	[     d   e  v3  v4]
+//	[         d   e  v4]
+//	[             d   e]
+//	[                 d]
+//
+// If uplo == blas.Lower, v[0:i+1] = 0, v[i+1] = 1, and v[i+2:] is stored in
+// A[i+2:n,i].
+// The elements of a are
+//
+//	[ d                ]
+//	[ e   d            ]
+//	[v1   e   d        ]
+//	[v1  v2   e   d    ]
+//	[v1  v2  v3   e   d]
+//
+// Hetd2 is an internal routine.
+func Hetd2(uplo blas.Uplo, n int, a []complex128, lda int, d, e []float64, tau []complex128) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(lapack.ErrBadUplo)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(lapack.ErrShortA)
+	case len(d) < n:
+		panic(lapack.ErrShortD)
+	case len(e) < n-1:
+		panic(lapack.ErrShortE)
+	case len(tau) < n-1:
+		panic(lapack.ErrShortTau)
+	}
+
+	if uplo == blas.Upper {
+		// Reduce the upper triangle of A.
+		a[(n-1)*lda+n-1] = complex(real(a[(n-1)*lda+n-1]), 0)
+		for i := n - 2; i >= 0; i-- {
+			// Generate elementary reflector H_i = I - tau * v * vᴴ to
+			// annihilate A[i:i-1, i+1].
+			var alpha, taui complex128
+			alpha, taui = Larfg(i+1, a[i*lda+i+1], a[i+1:], lda)
+			e[i] = real(alpha)
+			if taui != 0 {
+				// Apply H_i from both sides to A[0:i,0:i].
+				a[i*lda+i+1] = 1
+
+				// Compute x := tau * A * v storing x in tau[0:i].
+				c128.Hemv(uplo, i+1, taui, a, lda, a[i+1:], lda, 0, tau, 1)
+
+				// Compute w := x - 1/2 * tau * (xᴴ * v) * v.
+				alpha = -0.5 * taui * c128.Dotc(i+1, tau, 1, a[i+1:], lda)
+				c128.Axpy(i+1, alpha, a[i+1:], lda, tau, 1)
+
+				// Apply the transformation as a rank-2 update
+				// A = A - v * wᴴ - w * vᴴ.
+				c128.Her2(uplo, i+1, -1, a[i+1:], lda, tau, 1, a, lda)
+			} else {
+				a[i*lda+i] = complex(real(a[i*lda+i]), 0)
+			}
+			a[i*lda+i+1] = complex(e[i], 0)
+			d[i+1] = real(a[(i+1)*lda+i+1])
+			tau[i] = taui
+		}
+		d[0] = real(a[0])
+		return
+	}
+	// Reduce the lower triangle of A.
+	a[0] = complex(real(a[0]), 0)
+	for i := 0; i < n-1; i++ {
+		// Generate elementary reflector H_i = I - tau * v * vᴴ to
+		// annihilate A[i+2:n, i].
+		var alpha, taui complex128
+		alpha, taui = Larfg(n-i-1, a[(i+1)*lda+i], a[min(i+2, n-1)*lda+i:], lda)
+		e[i] = real(alpha)
+		if taui != 0 {
+			// Apply H_i from both sides to A[i+1:n, i+1:n].
+			a[(i+1)*lda+i] = 1
+
+			// Compute x := tau * A * v, storing y in tau[i:n-1].
+			c128.Hemv(uplo, n-i-1, taui, a[(i+1)*lda+i+1:], lda, a[(i+1)*lda+i:], lda, 0, tau[i:], 1)
+
+			// Compute w := x - 1/2 * tau * (xᴴ * v) * v.
+			alpha = -0.5 * taui * c128.Dotc(n-i-1, tau[i:], 1, a[(i+1)*lda+i:], lda)
+			c128.Axpy(n-i-1, alpha, a[(i+1)*lda+i:], lda, tau[i:], 1)
+
+			// Apply the transformation as a rank-2 update
+			// A = A - v * wᴴ - w * vᴴ.
+			c128.Her2(uplo, n-i-1, -1, a[(i+1)*lda+i:], lda, tau[i:], 1, a[(i+1)*lda+i+1:], lda)
+		} else {
+			a[(i+1)*lda+i+1] = complex(real(a[(i+1)*lda+i+1]), 0)
+		}
+		a[(i+1)*lda+i] = complex(e[i], 0)
+		d[i] = real(a[i*lda+i])
+		tau[i] = taui
+	}
+	d[n-1] = real(a[(n-1)*lda+n-1])
+}
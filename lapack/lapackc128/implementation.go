@@ -0,0 +1,64 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapackc128
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Implementation is the LAPACK implementation provided by this package. Its
+// methods call the package level functions of the same name, so it can be
+// passed wherever a lapack.Complex128 is expected.
+type Implementation struct{}
+
+var _ lapack.Complex128 = Implementation{}
+
+// Geqrf computes the QR factorization of the m×n matrix A using a blocked
+// algorithm.
+func (Implementation) Geqrf(m, n int, a []complex128, lda int, tau, work []complex128, lwork int) {
+	Geqrf(m, n, a, lda, tau, work, lwork)
+}
+
+// Getrf computes the LU decomposition of an m×n matrix A using partial pivoting
+// with row interchanges.
+func (Implementation) Getrf(m, n int, a []complex128, lda int, ipiv []int) (ok bool) {
+	return Getrf(m, n, a, lda, ipiv)
+}
+
+// Getrs solves a system of equations using an LU factorization.
+func (Implementation) Getrs(trans blas.Transpose, n, nrhs int, a []complex128, lda int, ipiv []int, b []complex128, ldb int) {
+	Getrs(trans, n, nrhs, a, lda, ipiv, b, ldb)
+}
+
+// Heev computes all eigenvalues and, optionally, the eigenvectors of a
+// Hermitian matrix A.
+func (Implementation) Heev(jobz lapack.EVJob, uplo blas.Uplo, n int, a []complex128, lda int, w []float64, work []complex128, lwork int, rwork []float64) (ok bool) {
+	return Heev(jobz, uplo, n, a, lda, w, work, lwork, rwork)
+}
+
+// Potrf computes the Cholesky decomposition of the Hermitian positive definite
+// matrix a.
+func (Implementation) Potrf(ul blas.Uplo, n int, a []complex128, lda int) (ok bool) {
+	return Potrf(ul, n, a, lda)
+}
+
+// Potrs solves a system of n linear equations A*X = B where A is an n×n
+// Hermitian positive definite matrix and B is an n×nrhs matrix.
+func (Implementation) Potrs(uplo blas.Uplo, n, nrhs int, a []complex128, lda int, b []complex128, ldb int) {
+	Potrs(uplo, n, nrhs, a, lda, b, ldb)
+}
+
+// Ungqr generates an m×n matrix Q with orthonormal columns defined by the
+// product of elementary reflectors.
+func (Implementation) Ungqr(m, n, k int, a []complex128, lda int, tau, work []complex128, lwork int) {
+	Ungqr(m, n, k, a, lda, tau, work, lwork)
+}
+
+// Unmqr multiplies an m×n matrix C by the unitary matrix Q from a QR
+// factorization.
+func (Implementation) Unmqr(side blas.Side, trans blas.Transpose, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int) {
+	Unmqr(side, trans, m, n, k, a, lda, tau, c, ldc, work, lwork)
+}
@@ -0,0 +1,36 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapackc128
+
+import (
+	"math/cmplx"
+
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Lacgv conjugates the n elements of the vector x with increment incX.
+//
+// Lacgv is an internal routine.
+func Lacgv(n int, x []complex128, incX int) {
+	switch {
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case incX <= 0:
+		panic(lapack.ErrBadIncX)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	if len(x) < 1+(n-1)*incX {
+		panic(lapack.ErrShortX)
+	}
+
+	for i := 0; i < n; i++ {
+		x[i*incX] = cmplx.Conj(x[i*incX])
+	}
+}
@@ -0,0 +1,328 @@
+package lapackc128
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/c128"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// randomMatrix returns an m×n matrix with real and imaginary parts of the
+// elements uniform in [-1, 1) and row stride n.
+func randomMatrix(m, n int, rnd *rand.Rand) []complex128 {
+	a := make([]complex128, m*n)
+	for i := range a {
+		a[i] = complex(2*rnd.Float64()-1, 2*rnd.Float64()-1)
+	}
+	return a
+}
+
+// randomHermitian returns a random n×n Hermitian matrix with row stride n.
+func randomHermitian(n int, rnd *rand.Rand) []complex128 {
+	a := randomMatrix(n, n, rnd)
+	for i := 0; i < n; i++ {
+		a[i*n+i] = complex(real(a[i*n+i]), 0)
+		for j := i + 1; j < n; j++ {
+			a[j*n+i] = cmplx.Conj(a[i*n+j])
+		}
+	}
+	return a
+}
+
+// randomHPD returns a random n×n Hermitian positive definite matrix with row
+// stride n.
+func randomHPD(n int, rnd *rand.Rand) []complex128 {
+	b := randomMatrix(n, n, rnd)
+	a := make([]complex128, n*n)
+	for i := 0; i < n; i++ {
+		a[i*n+i] = complex(float64(n), 0)
+	}
+	if n > 0 {
+		c128.Gemm(blas.ConjTrans, blas.NoTrans, n, n, n, 1, b, n, b, n, 1, a, n)
+	}
+	return a
+}
+
+// mul returns op(A)*op(B) with row stride n, where op(A) is m×k with row
+// stride lda and op(B) is k×n with row stride ldb.
+func mul(tA, tB blas.Transpose, m, n, k int, a []complex128, lda int, b []complex128, ldb int) []complex128 {
+	c := make([]complex128, m*n)
+	if m > 0 && n > 0 {
+		c128.Gemm(tA, tB, m, n, k, 1, a, max(1, lda), b, max(1, ldb), 0, c, max(1, n))
+	}
+	return c
+}
+
+// checkResidual reports an error if the Frobenius norm of A - B, for m×n
+// matrices with row stride n, is larger than tol times the size of the
+// problem, the machine epsilon and the norm of A.
+func checkResidual(t *testing.T, name string, m, n int, a, b []complex128, size int) {
+	t.Helper()
+	const tol = 10
+	var anorm, dnorm float64
+	for i := 0; i < m*n; i++ {
+		anorm += real(a[i])*real(a[i]) + imag(a[i])*imag(a[i])
+		d := a[i] - b[i]
+		dnorm += real(d)*real(d) + imag(d)*imag(d)
+	}
+	resid := math.Sqrt(dnorm) / (max(math.Sqrt(anorm), 1) * float64(max(size, 1)) * lamchE)
+	if !(resid <= tol) {
+		t.Errorf("%s: residual %v too large", name, resid)
+	}
+}
+
+// identity returns the n×n identity matrix with row stride n.
+func identity(n int) []complex128 {
+	a := make([]complex128, n*n)
+	for i := 0; i < n; i++ {
+		a[i*n+i] = 1
+	}
+	return a
+}
+
+func TestGetrf(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{{0, 0}, {1, 1}, {5, 3}, {3, 5}, {10, 10}, {65, 40}, {40, 65}, {100, 100}} {
+		m, n := dims[0], dims[1]
+		mn := min(m, n)
+		name := fmt.Sprintf("m=%d,n=%d", m, n)
+		a := randomMatrix(m, n, rnd)
+		lu := slices.Clone(a)
+		ipiv := make([]int, mn)
+		if !Getrf(m, n, lu, max(1, n), ipiv) {
+			t.Errorf("%s: unexpected singular matrix", name)
+			continue
+		}
+		if mn == 0 {
+			continue
+		}
+
+		// Form L, m×mn with unit diagonal, and U, mn×n.
+		l := make([]complex128, m*mn)
+		u := make([]complex128, mn*n)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				switch {
+				case i == j:
+					l[i*mn+j] = 1
+					u[i*n+j] = lu[i*n+j]
+				case i > j:
+					l[i*mn+j] = lu[i*n+j]
+				case i < mn:
+					u[i*n+j] = lu[i*n+j]
+				}
+			}
+		}
+		plu := mul(blas.NoTrans, blas.NoTrans, m, n, mn, l, mn, u, n)
+		Laswp(n, plu, n, 0, mn-1, ipiv, -1)
+		checkResidual(t, name+": A - P*L*U", m, n, a, plu, n)
+	}
+}
+
+func TestGetrs(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 5, 40, 100} {
+		for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+			const nrhs = 3
+			name := fmt.Sprintf("n=%d,trans=%c", n, trans)
+			a := randomMatrix(n, n, rnd)
+			b := randomMatrix(n, nrhs, rnd)
+			lu := slices.Clone(a)
+			ipiv := make([]int, n)
+			if !Getrf(n, n, lu, n, ipiv) {
+				t.Errorf("%s: unexpected singular matrix", name)
+				continue
+			}
+			x := slices.Clone(b)
+			Getrs(trans, n, nrhs, lu, n, ipiv, x, nrhs)
+			ax := mul(trans, blas.NoTrans, n, nrhs, n, a, n, x, nrhs)
+			checkResidual(t, name+": op(A)*X - B", n, nrhs, b, ax, n)
+		}
+	}
+}
+
+func TestPotrf(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 5, 40, 100} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			name := fmt.Sprintf("n=%d,uplo=%c", n, uplo)
+			a := randomHPD(n, rnd)
+			f := slices.Clone(a)
+			if !Potrf(uplo, n, f, max(1, n)) {
+				t.Errorf("%s: unexpected not positive definite", name)
+				continue
+			}
+
+			// Zero the triangle not referenced and form UᴴU or LLᴴ.
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					if (uplo == blas.Upper && j < i) || (uplo == blas.Lower && j > i) {
+						f[i*n+j] = 0
+					}
+				}
+			}
+			var ff []complex128
+			if uplo == blas.Upper {
+				ff = mul(blas.ConjTrans, blas.NoTrans, n, n, n, f, n, f, n)
+			} else {
+				ff = mul(blas.NoTrans, blas.ConjTrans, n, n, n, f, n, f, n)
+			}
+			checkResidual(t, name+": A - factor product", n, n, a, ff, n)
+
+			const nrhs = 2
+			b := randomMatrix(n, nrhs, rnd)
+			x := slices.Clone(b)
+			Potrs(uplo, n, nrhs, f, max(1, n), x, nrhs)
+			ax := mul(blas.NoTrans, blas.NoTrans, n, nrhs, n, a, n, x, nrhs)
+			checkResidual(t, name+": A*X - B", n, nrhs, b, ax, n)
+		}
+	}
+}
+
+func TestPotrfNotPD(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{1, 5, 100} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			a := randomHPD(n, rnd)
+			a[(n-1)*n+n-1] = -1
+			if Potrf(uplo, n, a, n) {
+				t.Errorf("n=%d,uplo=%c: indefinite matrix reported positive definite", n, uplo)
+			}
+		}
+	}
+}
+
+func TestGeqrf(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, dims := range [][2]int{{0, 0}, {1, 1}, {5, 3}, {3, 5}, {10, 10}, {65, 40}, {40, 65}, {100, 100}} {
+		m, n := dims[0], dims[1]
+		k := min(m, n)
+		name := fmt.Sprintf("m=%d,n=%d", m, n)
+		a := randomMatrix(m, n, rnd)
+		qr := slices.Clone(a)
+		lda := max(1, n)
+		tau := make([]complex128, k)
+		work := make([]complex128, 1)
+		Geqrf(m, n, qr, lda, tau, work, -1)
+		work = make([]complex128, max(n, int(real(work[0]))))
+		Geqrf(m, n, qr, lda, tau, work, len(work))
+
+		// Form R, m×n upper trapezoidal, and the m×m matrix Q.
+		r := make([]complex128, m*n)
+		q := make([]complex128, m*m)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				if j >= i {
+					r[i*n+j] = qr[i*n+j]
+				} else {
+					q[i*m+j] = qr[i*n+j]
+				}
+			}
+		}
+		ldq := max(1, m)
+		work = make([]complex128, 1)
+		Ungqr(m, m, k, q, ldq, tau, work, -1)
+		work = make([]complex128, max(m, int(real(work[0])), 1))
+		Ungqr(m, m, k, q, ldq, tau, work, len(work))
+
+		checkResidual(t, name+": A - Q*R", m, n, a, mul(blas.NoTrans, blas.NoTrans, m, n, m, q, m, r, n), max(m, n))
+		checkResidual(t, name+": QᴴQ - I", m, m, identity(m), mul(blas.ConjTrans, blas.NoTrans, m, m, m, q, m, q, m), m)
+
+		// Applying Qᴴ to A with Unmqr gives R.
+		c := slices.Clone(a)
+		work = make([]complex128, 1)
+		Unmqr(blas.Left, blas.ConjTrans, m, n, k, qr, lda, tau, c, lda, work, -1)
+		work = make([]complex128, max(n, int(real(work[0])), 1))
+		Unmqr(blas.Left, blas.ConjTrans, m, n, k, qr, lda, tau, c, lda, work, len(work))
+		checkResidual(t, name+": Qᴴ*A - R", m, n, r, c, max(m, n))
+
+		// Unmqr computes op(Q)*C or C*op(Q) with both the minimal and the
+		// optimal workspace.
+		const p = 7
+		for _, side := range []blas.Side{blas.Left, blas.Right} {
+			for _, trans := range []blas.Transpose{blas.NoTrans, blas.ConjTrans} {
+				mc, nc, minwork := m, p, p
+				if side == blas.Right {
+					mc, nc, minwork = p, m, p
+				}
+				c := randomMatrix(mc, nc, rnd)
+				var want []complex128
+				if side == blas.Left {
+					want = mul(trans, blas.NoTrans, mc, nc, m, q, m, c, nc)
+				} else {
+					want = mul(blas.NoTrans, trans, mc, nc, m, c, nc, q, m)
+				}
+				work := make([]complex128, 1)
+				Unmqr(side, trans, mc, nc, k, qr, lda, tau, c, max(1, nc), work, -1)
+				for _, lwork := range []int{max(1, minwork), max(1, minwork, int(real(work[0])))} {
+					got := slices.Clone(c)
+					work := make([]complex128, lwork)
+					Unmqr(side, trans, mc, nc, k, qr, lda, tau, got, max(1, nc), work, lwork)
+					checkResidual(t, fmt.Sprintf("%s,side=%c,trans=%c,lwork=%d: op(Q) applied to C", name, side, trans, lwork), mc, nc, want, got, m)
+				}
+			}
+		}
+	}
+}
+
+func TestHeev(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 1))
+	for _, n := range []int{0, 1, 2, 5, 40, 100} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			name := fmt.Sprintf("n=%d,uplo=%c", n, uplo)
+			a := randomHermitian(n, rnd)
+			lda := max(1, n)
+
+			// Only the triangle given by uplo is referenced.
+			v := slices.Clone(a)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					if (uplo == blas.Upper && j < i) || (uplo == blas.Lower && j > i) {
+						v[i*n+j] = cmplx.NaN()
+					}
+				}
+			}
+			vNone := slices.Clone(v)
+
+			w := make([]float64, n)
+			work := make([]complex128, 1)
+			Heev(lapack.EVCompute, uplo, n, v, lda, w, work, -1, nil)
+			work = make([]complex128, max(2*n-1, int(real(work[0])), 1))
+			rwork := make([]float64, max(1, 3*n-2))
+			if !Heev(lapack.EVCompute, uplo, n, v, lda, w, work, len(work), rwork) {
+				t.Errorf("%s: Heev did not converge", name)
+				continue
+			}
+			if !slices.IsSorted(w) {
+				t.Errorf("%s: eigenvalues not in ascending order: %v", name, w)
+			}
+
+			// A*V = V*Λ and VᴴV = I.
+			vl := slices.Clone(v)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					vl[i*n+j] *= complex(w[j], 0)
+				}
+			}
+			checkResidual(t, name+": A*V - V*Λ", n, n, vl, mul(blas.NoTrans, blas.NoTrans, n, n, n, a, n, v, n), n)
+			checkResidual(t, name+": VᴴV - I", n, n, identity(n), mul(blas.ConjTrans, blas.NoTrans, n, n, n, v, n, v, n), n)
+
+			wNone := make([]float64, n)
+			if !Heev(lapack.EVNone, uplo, n, vNone, lda, wNone, work, len(work), rwork) {
+				t.Errorf("%s: Heev with EVNone did not converge", name)
+				continue
+			}
+			for i := range w {
+				if math.Abs(w[i]-wNone[i]) > 1e3*lamchE*max(math.Abs(w[i]), 1) {
+					t.Errorf("%s: eigenvalue %d with EVNone = %v, want %v", name, i, wNone[i], w[i])
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapackc128
+
+import (
+	"math"
+
+	"github.com/gocnn/gomat/blas/c128"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Larfg generates an elementary reflector for a Householder matrix. It creates
+// a complex elementary reflector of order n such that
+//
+//	Hᴴ * (alpha) = (beta)
+//	     (    x)   (   0)
+//	Hᴴ * H = I
+//
+// where beta is real. H is represented in the form
+//
+//	H = I - tau * (1; v) * (1 vᴴ)
+//
+// where tau is a complex scalar with 1 ≤ real(tau) ≤ 2 and |tau-1| ≤ 1,
+// unless tau is zero and H is the identity. H is not Hermitian.
+//
+// On entry, x contains the vector x, on exit it contains v.
+//
+// Larfg is an internal routine.
+func Larfg(n int, alpha complex128, x []complex128, incX int) (beta, tau complex128) {
+	switch {
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case incX <= 0:
+		panic(lapack.ErrBadIncX)
+	}
+
+	if n <= 0 {
+		return alpha, 0
+	}
+
+	if len(x) < 1+(n-2)*abs(incX) {
+		panic(lapack.ErrShortX)
+	}
+
+	xnorm := c128.Nrm2(n-1, x, incX)
+	alphr := real(alpha)
+	alphi := imag(alpha)
+	if xnorm == 0 && alphi == 0 {
+		return alpha, 0
+	}
+	b := -math.Copysign(math.Hypot(math.Hypot(alphr, alphi), xnorm), alphr)
+	safmin := lamchS / lamchE
+	knt := 0
+	if math.Abs(b) < safmin {
+		// xnorm and beta may be inaccurate, scale x and recompute.
+		rsafmn := 1 / safmin
+		for {
+			knt++
+			c128.Dscal(n-1, rsafmn, x, incX)
+			b *= rsafmn
+			alphi *= rsafmn
+			alphr *= rsafmn
+			if math.Abs(b) >= safmin || knt >= 20 {
+				break
+			}
+		}
+		xnorm = c128.Nrm2(n-1, x, incX)
+		b = -math.Copysign(math.Hypot(math.Hypot(alphr, alphi), xnorm), alphr)
+	}
+	tau = complex((b-alphr)/b, -alphi/b)
+	c128.Scal(n-1, 1/(complex(alphr, alphi)-complex(b, 0)), x, incX)
+	for j := 0; j < knt; j++ {
+		b *= safmin
+	}
+	return complex(b, 0), tau
+}
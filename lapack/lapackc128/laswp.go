@@ -0,0 +1,59 @@
+// Copyright ©2015 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapackc128
+
+import (
+	"github.com/gocnn/gomat/blas/c128"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Laswp swaps the rows k1 to k2 of a rectangular matrix A according to the
+// indices in ipiv so that row k is swapped with ipiv[k].
+//
+// n is the number of columns of A and incX is the increment for ipiv. If incX
+// is 1, the swaps are applied from k1 to k2. If incX is -1, the swaps are
+// applied in reverse order from k2 to k1. For other values of incX Laswp will
+// panic. ipiv must have length k2+1, otherwise Laswp will panic.
+//
+// The indices k1, k2, and the elements of ipiv are zero-based.
+//
+// Laswp is an internal routine.
+func Laswp(n int, a []complex128, lda int, k1, k2 int, ipiv []int, incX int) {
+	switch {
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case k1 < 0:
+		panic(lapack.ErrK1Range)
+	case k2 < k1:
+		panic(lapack.ErrK2Range)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	case len(a) < k2*lda+n: // A must have at least k2+1 rows.
+		panic(lapack.ErrShortA)
+	case len(ipiv) != k2+1:
+		panic(lapack.ErrBadLenIpiv)
+	case incX != 1 && incX != -1:
+		panic(lapack.ErrAbsIncNotOne)
+	}
+
+	if n == 0 {
+		return
+	}
+	if incX == 1 {
+		for k := k1; k <= k2; k++ {
+			if k == ipiv[k] {
+				continue
+			}
+			c128.Swap(n, a[k*lda:], 1, a[ipiv[k]*lda:], 1)
+		}
+		return
+	}
+	for k := k2; k >= k1; k-- {
+		if k == ipiv[k] {
+			continue
+		}
+		c128.Swap(n, a[k*lda:], 1, a[ipiv[k]*lda:], 1)
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright ©2016 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapackc128
+
+import (
+	"github.com/gocnn/gomat/blas"
+	"github.com/gocnn/gomat/blas/c128"
+	"github.com/gocnn/gomat/lapack"
+)
+
+// Latrd reduces nb rows and columns of an n×n Hermitian matrix A to real
+// symmetric tridiagonal form. It computes the unitary similarity transformation
+//
+//	Qᴴ * A * Q
+//
+// and returns the matrices V and W to apply to the unreduced part of A. If
+// uplo == blas.Upper, the upper triangle is supplied and the last nb rows are
+// reduced. If uplo == blas.Lower, the lower triangle is supplied and the first
+// nb rows are reduced.
+//
+// a contains the Hermitian matrix on entry with active triangular half specified
+// by uplo. On exit, the nb columns have been reduced to tridiagonal form. The
+// diagonal contains the diagonal of the reduced matrix, the off-diagonal is
+// set to 1, and the remaining elements contain the data to construct Q.
+//
+// If uplo == blas.Upper, with n = 5 and nb = 2 on exit a is
+//
+//	[ a   a   a  v4  v5]
+//	[     a   a  v4  v5]
+//	[         a   1  v5]
+//	[             d   1]
+//	[                 d]
+//
+// If uplo == blas.Lower, with n = 5 and nb = 2, on exit a is
+//
+//	[ d                ]
+//	[ 1   d            ]
+//	[v1   1   a        ]
+//	[v1  v2   a   a    ]
+//	[v1  v2   a   a   a]
+//
+// e contains the superdiagonal elements of the reduced matrix. If uplo == blas.Upper,
+// e[n-nb:n-1] contains the last nb columns of the reduced matrix, while if
+// uplo == blas.Lower, e[:nb] contains the first nb columns of the reduced matrix.
+// e must have length at least n-1, and Latrd will panic otherwise.
+//
+// tau contains the scalar factors of the elementary reflectors needed to construct Q.
+// The reflectors are stored in tau[n-nb:n-1] if uplo == blas.Upper, and in
+// tau[:nb] if uplo == blas.Lower. tau must have length n-1, and Latrd will panic
+// otherwise.
+//
+// w is an n×nb matrix. On exit it contains the data to update the unreduced part
+// of A.
+//
+// The matrix Q is represented as a product of elementary reflectors. Each reflector
+// H has the form
+//
+//	I - tau * v * vᴴ
+//
+// If uplo == blas.Upper,
+//
+//	Q = H_{n-1} * H_{n-2} * ... * H_{n-nb}
+//
+// where v[:i-1] is stored in A[:i-1,i], v[i-1] = 1, and v[i:n] = 0.
+//
+// If uplo == blas.Lower,
+//
+//	Q = H_0 * H_1 * ... * H_{nb-1}
+//
+// where v[:i+1] = 0, v[i+1] = 1, and v[i+2:n] is stored in A[i+2:n,i].
+//
+// The vectors v form the n×nb matrix V which is used with W to apply a
+// Hermitian rank-2 update to the unreduced part of A
+//
+//	A = A - V * Wᴴ - W * Vᴴ
+//
+// Latrd is an internal routine.
+func Latrd(uplo blas.Uplo, n, nb int, a []complex128, lda int, e []float64, tau, w []complex128, ldw int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(lapack.ErrBadUplo)
+	case n < 0:
+		panic(lapack.ErrNLT0)
+	case nb < 0:
+		panic(lapack.ErrNbLT0)
+	case nb > n:
+		panic(lapack.ErrNbGTN)
+	case lda < max(1, n):
+		panic(lapack.ErrBadLdA)
+	case ldw < max(1, nb):
+		panic(lapack.ErrBadLdW)
+	}
+
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(lapack.ErrShortA)
+	case len(w) < (n-1)*ldw+nb:
+		panic(lapack.ErrShortW)
+	case len(e) < n-1:
+		panic(lapack.ErrShortE)
+	case len(tau) < n-1:
+		panic(lapack.ErrShortTau)
+	}
+
+	if uplo == blas.Upper {
+		for i := n - 1; i >= n-nb; i-- {
+			iw := i - n + nb
+			if i < n-1 {
+				// Update A(0:i, i).
+				a[i*lda+i] = complex(real(a[i*lda+i]), 0)
+				Lacgv(n-i-1, w[i*ldw+iw+1:], 1)
+				c128.Gemv(blas.NoTrans, i+1, n-i-1, -1, a[i+1:], lda,
+					w[i*ldw+iw+1:], 1, 1, a[i:], lda)
+				Lacgv(n-i-1, w[i*ldw+iw+1:], 1)
+				Lacgv(n-i-1, a[i*lda+i+1:], 1)
+				c128.Gemv(blas.NoTrans, i+1, n-i-1, -1, w[iw+1:], ldw,
+					a[i*lda+i+1:], 1, 1, a[i:], lda)
+				Lacgv(n-i-1, a[i*lda+i+1:], 1)
+				a[i*lda+i] = complex(real(a[i*lda+i]), 0)
+			}
+			if i > 0 {
+				// Generate elementary reflector H_i to annihilate A(0:i-2,i).
+				var alpha complex128
+				alpha, tau[i-1] = Larfg(i, a[(i-1)*lda+i], a[i:], lda)
+				e[i-1] = real(alpha)
+				a[(i-1)*lda+i] = 1
+
+				// Compute W(0:i-1, i).
+				c128.Hemv(blas.Upper, i, 1, a, lda, a[i:], lda, 0, w[iw:], ldw)
+				if i < n-1 {
+					c128.Gemv(blas.ConjTrans, i, n-i-1, 1, w[iw+1:], ldw,
+						a[i:], lda, 0, w[(i+1)*ldw+iw:], ldw)
+					c128.Gemv(blas.NoTrans, i, n-i-1, -1, a[i+1:], lda,
+						w[(i+1)*ldw+iw:], ldw, 1, w[iw:], ldw)
+					c128.Gemv(blas.ConjTrans, i, n-i-1, 1, a[i+1:], lda,
+						a[i:], lda, 0, w[(i+1)*ldw+iw:], ldw)
+					c128.Gemv(blas.NoTrans, i, n-i-1, -1, w[iw+1:], ldw,
+						w[(i+1)*ldw+iw:], ldw, 1, w[iw:], ldw)
+				}
+				c128.Scal(i, tau[i-1], w[iw:], ldw)
+				alpha = -0.5 * tau[i-1] * c128.Dotc(i, w[iw:], ldw, a[i:], lda)
+				c128.Axpy(i, alpha, a[i:], lda, w[iw:], ldw)
+			}
+		}
+	} else {
+		// Reduce first nb columns of lower triangle.
+		for i := 0; i < nb; i++ {
+			// Update A(i:n, i)
+			a[i*lda+i] = complex(real(a[i*lda+i]), 0)
+			Lacgv(i, w[i*ldw:], 1)
+			c128.Gemv(blas.NoTrans, n-i, i, -1, a[i*lda:], lda,
+				w[i*ldw:], 1, 1, a[i*lda+i:], lda)
+			Lacgv(i, w[i*ldw:], 1)
+			Lacgv(i, a[i*lda:], 1)
+			c128.Gemv(blas.NoTrans, n-i, i, -1, w[i*ldw:], ldw,
+				a[i*lda:], 1, 1, a[i*lda+i:], lda)
+			Lacgv(i, a[i*lda:], 1)
+			a[i*lda+i] = complex(real(a[i*lda+i]), 0)
+			if i < n-1 {
+				// Generate elementary reflector H_i to annihilate A(i+2:n,i).
+				var alpha complex128
+				alpha, tau[i] = Larfg(n-i-1, a[(i+1)*lda+i], a[min(i+2, n-1)*lda+i:], lda)
+				e[i] = real(alpha)
+				a[(i+1)*lda+i] = 1
+
+				// Compute W(i+1:n,i).
+				c128.Hemv(blas.Lower, n-i-1, 1, a[(i+1)*lda+i+1:], lda,
+					a[(i+1)*lda+i:], lda, 0, w[(i+1)*ldw+i:], ldw)
+				c128.Gemv(blas.ConjTrans, n-i-1, i, 1, w[(i+1)*ldw:], ldw,
+					a[(i+1)*lda+i:], lda, 0, w[i:], ldw)
+				c128.Gemv(blas.NoTrans, n-i-1, i, -1, a[(i+1)*lda:], lda,
+					w[i:], ldw, 1, w[(i+1)*ldw+i:], ldw)
+				c128.Gemv(blas.ConjTrans, n-i-1, i, 1, a[(i+1)*lda:], lda,
+					a[(i+1)*lda+i:], lda, 0, w[i:], ldw)
+				c128.Gemv(blas.NoTrans, n-i-1, i, -1, w[(i+1)*ldw:], ldw,
+					w[i:], ldw, 1, w[(i+1)*ldw+i:], ldw)
+				c128.Scal(n-i-1, tau[i], w[(i+1)*ldw+i:], ldw)
+				alpha = -0.5 * tau[i] * c128.Dotc(n-i-1, w[(i+1)*ldw+i:], ldw,
+					a[(i+1)*lda+i:], lda)
+				c128.Axpy(n-i-1, alpha, a[(i+1)*lda+i:], lda,
+					w[(i+1)*ldw+i:], ldw)
+			}
+		}
+	}
+}